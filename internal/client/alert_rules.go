@@ -11,14 +11,15 @@ import (
 
 // AlertRule represents a Phare alert rule
 type AlertRule struct {
-	ID            *int               `json:"id,omitempty"`
-	Event         string             `json:"event"`
-	IntegrationID int                `json:"integration_id"`
-	RateLimit     int                `json:"rate_limit"`
-	EventSettings AlertEventSettings `json:"event_settings"`
-	ProjectID     *int               `json:"project_id,omitempty"`
-	CreatedAt     *string            `json:"created_at,omitempty"`
-	UpdatedAt     *string            `json:"updated_at,omitempty"`
+	ID                 *int               `json:"id,omitempty"`
+	Event              string             `json:"event"`
+	IntegrationID      int                `json:"integration_id"`
+	RateLimit          int                `json:"rate_limit"`
+	EventSettings      AlertEventSettings `json:"event_settings"`
+	EscalationPolicyID *int               `json:"escalation_policy_id,omitempty"`
+	ProjectID          *int               `json:"project_id,omitempty"`
+	CreatedAt          *string            `json:"created_at,omitempty"`
+	UpdatedAt          *string            `json:"updated_at,omitempty"`
 }
 
 // AlertEventSettings represents the event settings for an alert rule
@@ -26,10 +27,12 @@ type AlertEventSettings struct {
 	Type string `json:"type"`
 }
 
+// alertRuleNullableFields lists the optional alert rule fields that are sent
+// as explicit nulls on update so removing them clears them.
+var alertRuleNullableFields = []string{"escalation_policy_id", "project_id"}
+
 // AlertRuleListResponse represents the response from listing alert rules
-type AlertRuleListResponse struct {
-	Data []AlertRule `json:"data"`
-}
+type AlertRuleListResponse = ListResponse[AlertRule]
 
 // AlertRuleResponse represents the response from creating/getting an alert rule
 type AlertRuleResponse struct {
@@ -68,7 +71,12 @@ func (c *Client) GetAlertRule(ctx context.Context, id int) (*AlertRule, error) {
 
 // UpdateAlertRule updates an existing alert rule
 func (c *Client) UpdateAlertRule(ctx context.Context, id int, rule *AlertRule) (*AlertRule, error) {
-	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/alert-rules/%d", id), rule)
+	payload, err := updatePayload(rule, alertRuleNullableFields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update alert rule: %w", err)
+	}
+
+	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/alert-rules/%d", id), payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update alert rule: %w", err)
 	}
@@ -91,17 +99,12 @@ func (c *Client) DeleteAlertRule(ctx context.Context, id int) error {
 	return nil
 }
 
-// ListAlertRules lists all alert rules
+// ListAlertRules lists all alert rules, following the pagination cursor
 func (c *Client) ListAlertRules(ctx context.Context) ([]AlertRule, error) {
-	respBody, err := c.doRequest(ctx, "GET", "/alert-rules", nil)
+	rules, err := listAll[AlertRule](ctx, c, "/alert-rules", 0)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list alert rules: %w", err)
 	}
 
-	var resp AlertRuleListResponse
-	if err := json.Unmarshal(respBody, &resp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
-	return resp.Data, nil
+	return rules, nil
 }
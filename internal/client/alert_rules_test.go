@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpdateAlertRule(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/alert-rules/1" {
+			t.Errorf("request = %s %s, want POST /alert-rules/1", r.Method, r.URL.Path)
+		}
+
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		for _, field := range []string{"escalation_policy_id", "project_id"} {
+			if value, ok := payload[field]; !ok || value != nil {
+				t.Errorf("payload %s = %v, want explicit null", field, payload[field])
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1, "event": "monitor_created", "integration_id": 2, "rate_limit": 0}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("token", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	updated, err := c.UpdateAlertRule(context.Background(), 1, &AlertRule{
+		Event:         "monitor_created",
+		IntegrationID: 2,
+	})
+	if err != nil {
+		t.Fatalf("UpdateAlertRule() unexpected error: %v", err)
+	}
+	if updated.EscalationPolicyID != nil {
+		t.Errorf("UpdateAlertRule() escalation_policy_id = %d, want unset", *updated.EscalationPolicyID)
+	}
+}
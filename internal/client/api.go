@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import "context"
+
+// MonitorAPI is the set of uptime monitor operations used by the provider.
+// It is satisfied by Client and allows resources to be tested against fakes.
+type MonitorAPI interface {
+	CreateMonitor(ctx context.Context, monitor *Monitor) (*Monitor, error)
+	GetMonitor(ctx context.Context, id int) (*Monitor, error)
+	UpdateMonitor(ctx context.Context, id int, monitor *Monitor) (*Monitor, error)
+	DeleteMonitor(ctx context.Context, id int) error
+	PauseMonitor(ctx context.Context, id int) error
+	ResumeMonitor(ctx context.Context, id int) error
+}
+
+// StatusPageAPI is the set of status page operations used by the provider.
+// It is satisfied by Client and allows resources to be tested against fakes.
+type StatusPageAPI interface {
+	CreateStatusPage(ctx context.Context, page *StatusPage) (*StatusPage, error)
+	GetStatusPage(ctx context.Context, id int) (*StatusPage, error)
+	UpdateStatusPage(ctx context.Context, id int, page *StatusPage) (*StatusPage, error)
+	DeleteStatusPage(ctx context.Context, id int) error
+}
+
+// Ensure Client satisfies the API interfaces.
+var _ MonitorAPI = &Client{}
+var _ StatusPageAPI = &Client{}
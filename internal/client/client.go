@@ -7,26 +7,192 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
 	DefaultBaseURL = "https://api.phare.io"
 	DefaultTimeout = 30 * time.Second
+
+	// DefaultMaxRegions is the highest number of regions a monitor can use
+	// on any plan
+	DefaultMaxRegions = 6
+
+	// DefaultAcceptMediaType is the media type requested for API responses
+	DefaultAcceptMediaType = "application/json"
+
+	// DefaultUserAgent identifies requests when no provider version is known
+	DefaultUserAgent = "terraform-provider-phare"
+
+	// DefaultMaxRetries is the number of times a request failing with a
+	// transient error is retried
+	DefaultMaxRetries = 3
+
+	// DefaultRetryDelay is the initial wait between retries of transient
+	// errors, doubled on each attempt
+	DefaultRetryDelay = 500 * time.Millisecond
 )
 
 // Client represents a Phare API client
 type Client struct {
-	baseURL    string
-	apiToken   string
-	httpClient *http.Client
+	baseURL            string
+	apiToken           string
+	httpClient         *http.Client
+	strictErrorParsing bool
+	maxRegions         int
+	verifyDelete       bool
+	logChanges         bool
+	defaultColors      StatusPageColors
+	acceptMediaType    string
+	maxRetries         int
+	retryDelay         time.Duration
+	retryOnStatus      []int
+	userAgent          string
+
+	rateLimit rateLimiter
+
+	integrationsMu sync.Mutex
+	integrations   map[int]*Integration
+
+	scopedMu sync.Mutex
+	scoped   map[string]*Client
+}
+
+// Option configures optional Client behavior
+type Option func(*Client)
+
+// WithStrictErrorParsing makes error responses that don't match the standard
+// ErrorResponse shape fail with the full raw body instead of best-effort formatting
+func WithStrictErrorParsing(strict bool) Option {
+	return func(c *Client) {
+		c.strictErrorParsing = strict
+	}
+}
+
+// WithMaxRegions sets the number of regions a monitor may use on the
+// account's plan, values below 1 keep DefaultMaxRegions
+func WithMaxRegions(n int) Option {
+	return func(c *Client) {
+		if n > 0 {
+			c.maxRegions = n
+		}
+	}
+}
+
+// MaxRegions returns the number of regions a monitor may use
+func (c *Client) MaxRegions() int {
+	return c.maxRegions
+}
+
+// WithVerifyDelete makes resources confirm deletions by reading the deleted
+// resource until the API reports it as not found
+func WithVerifyDelete(verify bool) Option {
+	return func(c *Client) {
+		c.verifyDelete = verify
+	}
+}
+
+// VerifyDelete reports whether deletions should be confirmed with a read
+func (c *Client) VerifyDelete() bool {
+	return c.verifyDelete
+}
+
+// WithLogChanges makes resources log the attributes changed by each update
+func WithLogChanges(logChanges bool) Option {
+	return func(c *Client) {
+		c.logChanges = logChanges
+	}
+}
+
+// LogChanges reports whether updates should log their changed attributes
+func (c *Client) LogChanges() bool {
+	return c.logChanges
+}
+
+// WithDefaultColors sets the colors of status pages that don't configure
+// them, empty colors keep those of DefaultStatusPageColors
+func WithDefaultColors(colors StatusPageColors) Option {
+	return func(c *Client) {
+		c.defaultColors = colors.withFallback(DefaultStatusPageColors)
+	}
+}
+
+// DefaultColors returns the colors of status pages that don't configure them
+func (c *Client) DefaultColors() StatusPageColors {
+	return c.defaultColors
+}
+
+// WithTimeout sets the timeout of each HTTP request, values below or equal
+// to 0 keep DefaultTimeout
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		if timeout > 0 {
+			c.httpClient.Timeout = timeout
+		}
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request, an empty
+// value keeps DefaultUserAgent
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		if userAgent != "" {
+			c.userAgent = userAgent
+		}
+	}
+}
+
+// WithAcceptMediaType sets the media type sent in the Accept header, which
+// pins the version of API responses. An empty value keeps DefaultAcceptMediaType.
+func WithAcceptMediaType(mediaType string) Option {
+	return func(c *Client) {
+		if mediaType != "" {
+			c.acceptMediaType = mediaType
+		}
+	}
+}
+
+// WithMaxRetries sets the number of times a request failing with a status
+// listed by WithRetryOnStatus is retried, 0 disables retries and negative
+// values keep DefaultMaxRetries
+func WithMaxRetries(n int) Option {
+	return func(c *Client) {
+		if n >= 0 {
+			c.maxRetries = n
+		}
+	}
+}
+
+// WithRetryDelay sets the initial wait between retries of transient errors
+// without a Retry-After header, values below or equal to 0 keep
+// DefaultRetryDelay
+func WithRetryDelay(d time.Duration) Option {
+	return func(c *Client) {
+		if d > 0 {
+			c.retryDelay = d
+		}
+	}
+}
+
+// WithRetryOnStatus sets the response status codes retried, a nil slice
+// keeps DefaultRetryOnStatus
+func WithRetryOnStatus(codes []int) Option {
+	return func(c *Client) {
+		if codes != nil {
+			c.retryOnStatus = codes
+		}
+	}
 }
 
 // NewClient creates a new Phare API client
-func NewClient(apiToken, baseURL string) (*Client, error) {
+func NewClient(apiToken, baseURL string, opts ...Option) (*Client, error) {
 	if apiToken == "" {
 		return nil, fmt.Errorf("api_token is required")
 	}
@@ -35,13 +201,64 @@ func NewClient(apiToken, baseURL string) (*Client, error) {
 		baseURL = DefaultBaseURL
 	}
 
-	return &Client{
-		baseURL:  baseURL,
-		apiToken: apiToken,
+	c := &Client{
+		baseURL:         baseURL,
+		apiToken:        apiToken,
+		maxRegions:      DefaultMaxRegions,
+		acceptMediaType: DefaultAcceptMediaType,
+		maxRetries:      DefaultMaxRetries,
+		retryDelay:      DefaultRetryDelay,
+		retryOnStatus:   DefaultRetryOnStatus,
+		userAgent:       DefaultUserAgent,
+		defaultColors:   DefaultStatusPageColors,
 		httpClient: &http.Client{
 			Timeout: DefaultTimeout,
 		},
-	}, nil
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// ForBaseURL returns a client sending requests to baseURL with the same
+// credentials and options. Clients are reused per base URL so that their
+// caches are shared between resources.
+func (c *Client) ForBaseURL(baseURL string) *Client {
+	if baseURL == "" || baseURL == c.baseURL {
+		return c
+	}
+
+	c.scopedMu.Lock()
+	defer c.scopedMu.Unlock()
+
+	if scoped, ok := c.scoped[baseURL]; ok {
+		return scoped
+	}
+
+	scoped := &Client{
+		baseURL:            baseURL,
+		apiToken:           c.apiToken,
+		httpClient:         c.httpClient,
+		strictErrorParsing: c.strictErrorParsing,
+		maxRegions:         c.maxRegions,
+		verifyDelete:       c.verifyDelete,
+		logChanges:         c.logChanges,
+		defaultColors:      c.defaultColors,
+		acceptMediaType:    c.acceptMediaType,
+		maxRetries:         c.maxRetries,
+		retryDelay:         c.retryDelay,
+		retryOnStatus:      c.retryOnStatus,
+		userAgent:          c.userAgent,
+	}
+	if c.scoped == nil {
+		c.scoped = make(map[string]*Client)
+	}
+	c.scoped[baseURL] = scoped
+
+	return scoped
 }
 
 // ErrorResponse represents a Phare API error response
@@ -50,51 +267,225 @@ type ErrorResponse struct {
 	Errors  map[string][]string `json:"errors,omitempty"`
 }
 
-// doRequest performs an HTTP request with proper authentication and error handling
+// ErrNotFound is matched by errors.Is for every NotFoundError, so callers can
+// detect resources deleted outside Terraform without a type assertion
+var ErrNotFound = errors.New("resource not found")
+
+// NotFoundError is returned when the API responds with 404 Not Found
+type NotFoundError struct {
+	Message string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("API error (status %d): %s", http.StatusNotFound, e.Message)
+}
+
+// Unwrap exposes the error as an APIError, so that callers branching on
+// status codes with errors.As also see 404 responses
+func (e *NotFoundError) Unwrap() error {
+	return &APIError{StatusCode: http.StatusNotFound, Message: e.Message}
+}
+
+// Is reports whether target is ErrNotFound
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// IsNotFound reports whether err is or wraps a NotFoundError
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// APIError is returned when the API responds with an error status other than
+// 404 Not Found. Errors holds the per-field validation messages, if any.
+type APIError struct {
+	StatusCode int
+	Message    string
+	Errors     map[string][]string
+}
+
+func (e *APIError) Error() string {
+	if len(e.Errors) > 0 {
+		return fmt.Sprintf("API error (status %d): %s - validation errors: %+v", e.StatusCode, e.Message, e.Errors)
+	}
+	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Message)
+}
+
+// IsConflict reports whether err is or wraps an APIError rejecting a write
+// because the resource was modified since it was last read
+func IsConflict(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) &&
+		(apiErr.StatusCode == http.StatusConflict || apiErr.StatusCode == http.StatusPreconditionFailed)
+}
+
+// doRequest performs an HTTP request with proper authentication and error handling.
+// Requests rejected while the API is under planned maintenance are retried, as
+// are requests failing with a transient error, see isTransientResponse.
+// Requests wait for the rate limit to reset when it is almost reached.
+// A 404 on the collection root of a feature returns an UnsupportedFeatureError.
 func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
-	var reqBody io.Reader
+	return c.doRequestWithHeaders(ctx, method, path, body, nil)
+}
+
+// doRequestWithHeaders performs a request like doRequest, adding the given
+// headers to the request
+func (c *Client) doRequestWithHeaders(ctx context.Context, method, path string, body interface{}, headers http.Header) ([]byte, error) {
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewBuffer(jsonBody)
+	}
+
+	var maintenanceRetries, retries int
+	for {
+		if err := c.throttle(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, respBody, err := c.send(ctx, method, path, jsonBody, headers)
+		if err != nil {
+			return nil, err
+		}
+		c.rateLimit.update(resp.Header, time.Now())
+
+		var delay time.Duration
+		switch {
+		case isMaintenanceResponse(resp.StatusCode, respBody):
+			if maintenanceRetries >= maintenanceMaxRetries {
+				return c.handleResponse(resp.StatusCode, respBody)
+			}
+			delay = maintenanceRetryDelay(resp.Header.Get("Retry-After"), maintenanceRetries)
+			maintenanceRetries++
+			tflog.Warn(ctx, "Phare API under maintenance, retrying", map[string]any{
+				"attempt":     maintenanceRetries,
+				"retry_after": delay.String(),
+			})
+		case isTransientResponse(method, resp.StatusCode, c.retryOnStatus) && retries < c.maxRetries:
+			delay = transientRetryDelay(resp.Header.Get("Retry-After"), c.retryDelay, retries)
+			retries++
+			tflog.Warn(ctx, "Transient Phare API error, retrying", map[string]any{
+				"status":      resp.StatusCode,
+				"attempt":     retries,
+				"retry_after": delay.String(),
+			})
+		case resp.StatusCode == http.StatusNotFound:
+			if err := unsupportedFeature(method, path); err != nil {
+				return nil, err
+			}
+			return c.handleResponse(resp.StatusCode, respBody)
+		default:
+			return c.handleResponse(resp.StatusCode, respBody)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// throttle waits for the rate limit window to reset when the previous
+// responses reported that few requests remain in it
+func (c *Client) throttle(ctx context.Context) error {
+	delay := c.rateLimit.delay(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Phare API rate limit almost reached, waiting for reset", map[string]any{
+		"wait": delay.String(),
+	})
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+// send performs a single HTTP request and reads the full response body
+func (c *Client) send(ctx context.Context, method, path string, jsonBody []byte, headers http.Header) (*http.Response, []byte, error) {
+	var reqBody io.Reader
+	if jsonBody != nil {
+		reqBody = bytes.NewReader(jsonBody)
 	}
 
 	url := c.baseURL + path
 	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", "Bearer "+c.apiToken)
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Accept", c.acceptMediaType)
+	req.Header.Set("User-Agent", c.userAgent)
+	for name, values := range headers {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return nil, nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	// Handle error responses
-	if resp.StatusCode >= 400 {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(respBody, &errResp); err != nil {
-			return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
-		}
+	return resp, respBody, nil
+}
+
+// handleResponse converts error responses into a NotFoundError for 404
+// responses and an APIError otherwise, and returns the body on success
+func (c *Client) handleResponse(statusCode int, respBody []byte) ([]byte, error) {
+	if statusCode < 400 {
+		return respBody, nil
+	}
 
-		if len(errResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error (status %d): %s - validation errors: %+v",
-				resp.StatusCode, errResp.Message, errResp.Errors)
+	var errResp ErrorResponse
+	if c.strictErrorParsing {
+		if err := validateErrorResponse(respBody); err != nil {
+			errResp = ErrorResponse{
+				Message: fmt.Sprintf("unexpected error response: %s - raw body: %s", err, string(respBody)),
+			}
+		} else {
+			_ = json.Unmarshal(respBody, &errResp)
 		}
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, errResp.Message)
+	} else if err := json.Unmarshal(respBody, &errResp); err != nil {
+		errResp = ErrorResponse{Message: string(respBody)}
+	}
+
+	if statusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Message: errResp.Message}
+	}
+
+	return nil, &APIError{StatusCode: statusCode, Message: errResp.Message, Errors: errResp.Errors}
+}
+
+// validateErrorResponse checks that an error body strictly matches the
+// standard ErrorResponse shape
+func validateErrorResponse(respBody []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(respBody))
+	decoder.DisallowUnknownFields()
+
+	var errResp ErrorResponse
+	if err := decoder.Decode(&errResp); err != nil {
+		return err
+	}
+	if errResp.Message == "" {
+		return errors.New("missing message")
 	}
 
-	return respBody, nil
+	return nil
 }
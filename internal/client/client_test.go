@@ -4,7 +4,13 @@
 package client
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -69,3 +75,349 @@ func TestNewClient(t *testing.T) {
 		})
 	}
 }
+
+func TestStrictErrorParsing(t *testing.T) {
+	const body = `{"error": {"code": "unexpected"}}`
+
+	tests := []struct {
+		name        string
+		strict      bool
+		wantRawBody bool
+	}{
+		{
+			name:        "best-effort formatting by default",
+			strict:      false,
+			wantRawBody: false,
+		},
+		{
+			name:        "strict mode includes raw body",
+			strict:      true,
+			wantRawBody: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(body))
+			}))
+			defer server.Close()
+
+			client, err := NewClient("test-token", server.URL, WithStrictErrorParsing(tt.strict), WithMaxRetries(0))
+			if err != nil {
+				t.Fatalf("NewClient() unexpected error: %v", err)
+			}
+
+			_, err = client.doRequest(context.Background(), "GET", "/uptime/monitors", nil)
+			if err == nil {
+				t.Fatal("doRequest() expected error but got none")
+			}
+
+			if got := strings.Contains(err.Error(), body); got != tt.wantRawBody {
+				t.Errorf("doRequest() error = %q, contains raw body = %v, want %v", err.Error(), got, tt.wantRawBody)
+			}
+		})
+	}
+}
+
+func TestAPIErrorFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"message": "The given data was invalid.", "errors": {"timeframe": ["The selected timeframe is invalid."]}}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("token", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	_, err = c.doRequest(context.Background(), "POST", "/uptime/status-pages", nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusUnprocessableEntity)
+	}
+	if got := apiErr.Errors["timeframe"]; len(got) != 1 || got[0] != "The selected timeframe is invalid." {
+		t.Errorf("Errors[timeframe] = %v", got)
+	}
+}
+
+func TestAPIErrorStatusCode(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		strict     bool
+	}{
+		{
+			name:       "unauthorized",
+			statusCode: http.StatusUnauthorized,
+			body:       `{"message": "Unauthenticated."}`,
+		},
+		{
+			name:       "not found",
+			statusCode: http.StatusNotFound,
+			body:       `{"message": "Not found."}`,
+		},
+		{
+			name:       "not found with unexpected body in strict mode",
+			statusCode: http.StatusNotFound,
+			body:       `not json`,
+			strict:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			c, err := NewClient("token", server.URL, WithStrictErrorParsing(tt.strict))
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			_, err = c.GetMonitor(context.Background(), 1)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *APIError, got %T: %v", err, err)
+			}
+			if apiErr.StatusCode != tt.statusCode {
+				t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, tt.statusCode)
+			}
+			if got := IsNotFound(err); got != (tt.statusCode == http.StatusNotFound) {
+				t.Errorf("IsNotFound() = %v", got)
+			}
+			if got := errors.Is(err, ErrNotFound); got != (tt.statusCode == http.StatusNotFound) {
+				t.Errorf("errors.Is(err, ErrNotFound) = %v", got)
+			}
+		})
+	}
+}
+
+func TestForBaseURL(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("token", "https://api.example.com", WithMaxRegions(3))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if got := c.ForBaseURL(""); got != c {
+		t.Error("ForBaseURL(\"\") should return the same client")
+	}
+
+	scoped := c.ForBaseURL(server.URL)
+	if scoped == c {
+		t.Fatal("ForBaseURL() returned the provider client for a different base URL")
+	}
+	if again := c.ForBaseURL(server.URL); again != scoped {
+		t.Error("ForBaseURL() should reuse the client for the same base URL")
+	}
+	if scoped.MaxRegions() != 3 {
+		t.Errorf("MaxRegions() = %d, want 3", scoped.MaxRegions())
+	}
+
+	if _, err := scoped.doRequest(context.Background(), "GET", "/uptime/monitors", nil); err != nil {
+		t.Fatalf("doRequest() unexpected error: %v", err)
+	}
+	if gotAuth != "Bearer token" {
+		t.Errorf("Authorization = %q, want %q", gotAuth, "Bearer token")
+	}
+}
+
+func TestAcceptMediaType(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []Option
+		wantValue string
+	}{
+		{
+			name:      "default",
+			wantValue: DefaultAcceptMediaType,
+		},
+		{
+			name:      "empty keeps default",
+			opts:      []Option{WithAcceptMediaType("")},
+			wantValue: DefaultAcceptMediaType,
+		},
+		{
+			name:      "versioned media type",
+			opts:      []Option{WithAcceptMediaType("application/vnd.phare.v1+json")},
+			wantValue: "application/vnd.phare.v1+json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotAccept string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotAccept = r.Header.Get("Accept")
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			c, err := NewClient("test-token", server.URL, tt.opts...)
+			if err != nil {
+				t.Fatalf("NewClient() unexpected error: %v", err)
+			}
+
+			if _, err := c.doRequest(context.Background(), "GET", "/uptime/monitors", nil); err != nil {
+				t.Fatalf("doRequest() unexpected error: %v", err)
+			}
+
+			if gotAccept != tt.wantValue {
+				t.Errorf("Accept = %q, want %q", gotAccept, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		want    time.Duration
+	}{
+		{
+			name:    "zero keeps default",
+			timeout: 0,
+			want:    DefaultTimeout,
+		},
+		{
+			name:    "custom timeout",
+			timeout: 2 * time.Minute,
+			want:    2 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClient("test-token", "", WithTimeout(tt.timeout))
+			if err != nil {
+				t.Fatalf("NewClient() unexpected error: %v", err)
+			}
+
+			if got := c.httpClient.Timeout; got != tt.want {
+				t.Errorf("httpClient.Timeout = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithDefaultColors(t *testing.T) {
+	tests := []struct {
+		name   string
+		colors StatusPageColors
+		want   StatusPageColors
+	}{
+		{
+			name: "unset keeps built-in defaults",
+			want: DefaultStatusPageColors,
+		},
+		{
+			name:   "partial colors keep remaining defaults",
+			colors: StatusPageColors{Operational: "#000000", Empty: "#ffffff"},
+			want: StatusPageColors{
+				Operational:         "#000000",
+				DegradedPerformance: DefaultStatusPageColors.DegradedPerformance,
+				PartialOutage:       DefaultStatusPageColors.PartialOutage,
+				MajorOutage:         DefaultStatusPageColors.MajorOutage,
+				Maintenance:         DefaultStatusPageColors.Maintenance,
+				Empty:               "#ffffff",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClient("test-token", "", WithDefaultColors(tt.colors))
+			if err != nil {
+				t.Fatalf("NewClient() unexpected error: %v", err)
+			}
+
+			if got := c.ForBaseURL("https://eu.example.com").DefaultColors(); got != tt.want {
+				t.Errorf("DefaultColors() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithTimeoutAppliedToRequests(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	c, err := NewClient("test-token", server.URL, WithTimeout(50*time.Millisecond), WithMaxRetries(0))
+	if err != nil {
+		t.Fatalf("NewClient() unexpected error: %v", err)
+	}
+
+	_, err = c.doRequest(context.Background(), http.MethodGet, "/uptime/monitors", nil)
+	if err == nil {
+		t.Fatal("doRequest() expected a timeout error but got none")
+	}
+	if !strings.Contains(err.Error(), "Client.Timeout exceeded") {
+		t.Errorf("doRequest() error = %v, want the client timeout to be exceeded", err)
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []Option
+		wantValue string
+	}{
+		{
+			name:      "default",
+			wantValue: DefaultUserAgent,
+		},
+		{
+			name:      "provider version",
+			opts:      []Option{WithUserAgent("terraform-provider-phare/1.2.3 (terraform-plugin-framework)")},
+			wantValue: "terraform-provider-phare/1.2.3 (terraform-plugin-framework)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotUserAgent string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotUserAgent = r.Header.Get("User-Agent")
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			c, err := NewClient("test-token", server.URL, tt.opts...)
+			if err != nil {
+				t.Fatalf("NewClient() unexpected error: %v", err)
+			}
+
+			if _, err := c.doRequest(context.Background(), "GET", "/uptime/monitors", nil); err != nil {
+				t.Fatalf("doRequest() unexpected error: %v", err)
+			}
+
+			if gotUserAgent != tt.wantValue {
+				t.Errorf("User-Agent = %q, want %q", gotUserAgent, tt.wantValue)
+			}
+		})
+	}
+}
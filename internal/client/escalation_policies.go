@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// EscalationPolicy represents a Phare notification escalation policy
+type EscalationPolicy struct {
+	ID        *int             `json:"id,omitempty"`
+	Name      string           `json:"name"`
+	Steps     []EscalationStep `json:"steps"`
+	ProjectID *int             `json:"project_id,omitempty"`
+	CreatedAt *string          `json:"created_at,omitempty"`
+	UpdatedAt *string          `json:"updated_at,omitempty"`
+}
+
+// EscalationStep represents a single step of an escalation policy
+type EscalationStep struct {
+	Delay         int `json:"delay"`
+	IntegrationID int `json:"integration_id"`
+}
+
+// escalationPolicyNullableFields lists the optional escalation policy fields
+// that are sent as explicit nulls on update so removing them clears them.
+var escalationPolicyNullableFields = []string{"project_id"}
+
+// EscalationPolicyListResponse represents the response from listing escalation policies
+type EscalationPolicyListResponse = ListResponse[EscalationPolicy]
+
+// EscalationPolicyResponse represents the response from creating/getting an escalation policy
+type EscalationPolicyResponse struct {
+	Data EscalationPolicy `json:"data"`
+}
+
+// CreateEscalationPolicy creates a new escalation policy
+func (c *Client) CreateEscalationPolicy(ctx context.Context, policy *EscalationPolicy) (*EscalationPolicy, error) {
+	respBody, err := c.doRequest(ctx, "POST", "/escalation-policies", policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create escalation policy: %w", err)
+	}
+
+	var created EscalationPolicy
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetEscalationPolicy retrieves an escalation policy by ID
+func (c *Client) GetEscalationPolicy(ctx context.Context, id int) (*EscalationPolicy, error) {
+	respBody, err := c.doRequest(ctx, "GET", fmt.Sprintf("/escalation-policies/%d", id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get escalation policy: %w", err)
+	}
+
+	var policy EscalationPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &policy, nil
+}
+
+// UpdateEscalationPolicy updates an existing escalation policy
+func (c *Client) UpdateEscalationPolicy(ctx context.Context, id int, policy *EscalationPolicy) (*EscalationPolicy, error) {
+	payload, err := updatePayload(policy, escalationPolicyNullableFields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update escalation policy: %w", err)
+	}
+
+	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/escalation-policies/%d", id), payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update escalation policy: %w", err)
+	}
+
+	var updated EscalationPolicy
+	if err := json.Unmarshal(respBody, &updated); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteEscalationPolicy deletes an escalation policy
+func (c *Client) DeleteEscalationPolicy(ctx context.Context, id int) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/escalation-policies/%d", id), nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete escalation policy: %w", err)
+	}
+
+	return nil
+}
+
+// ListEscalationPolicies lists all escalation policies, following the
+// pagination cursor
+func (c *Client) ListEscalationPolicies(ctx context.Context) ([]EscalationPolicy, error) {
+	policies, err := listAll[EscalationPolicy](ctx, c, "/escalation-policies", 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list escalation policies: %w", err)
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpdateEscalationPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/escalation-policies/1" {
+			t.Errorf("request = %s %s, want POST /escalation-policies/1", r.Method, r.URL.Path)
+		}
+
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		if projectID, ok := payload["project_id"]; !ok || projectID != nil {
+			t.Errorf("payload project_id = %v, want explicit null", payload["project_id"])
+		}
+		if _, ok := payload["steps"]; !ok {
+			t.Errorf("payload is missing %q", "steps")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1, "name": "On-call", "steps": [{"delay": 0, "integration_id": 2}]}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("token", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	updated, err := c.UpdateEscalationPolicy(context.Background(), 1, &EscalationPolicy{
+		Name:  "On-call",
+		Steps: []EscalationStep{{Delay: 0, IntegrationID: 2}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateEscalationPolicy() unexpected error: %v", err)
+	}
+	if updated.ProjectID != nil {
+		t.Errorf("UpdateEscalationPolicy() project_id = %d, want unset", *updated.ProjectID)
+	}
+}
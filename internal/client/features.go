@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// collectionFeatures maps the collection root of each API endpoint to the
+// feature it implements. Older self-hosted Phare deployments respond with 404
+// Not Found on the collection roots of features they don't implement.
+var collectionFeatures = map[string]string{
+	"/uptime/monitors":            "uptime monitors",
+	"/uptime/status-pages":        "status pages",
+	"/uptime/incidents":           "incidents",
+	"/uptime/maintenance-windows": "maintenance windows",
+	"/alert-rules":                "alert rules",
+	"/escalation-policies":        "escalation policies",
+	"/integrations":               "integrations",
+}
+
+// UnsupportedFeatureError is returned when the API responds with 404 Not
+// Found on the collection root of a feature, meaning the Phare deployment
+// doesn't implement the feature rather than a resource being missing.
+type UnsupportedFeatureError struct {
+	Feature string
+	Method  string
+	Path    string
+}
+
+func (e *UnsupportedFeatureError) Error() string {
+	return fmt.Sprintf("this Phare deployment does not support %s (%s %s returned 404 Not Found), "+
+		"upgrade it or remove the %s from the configuration", e.Feature, e.Method, e.Path, e.Feature)
+}
+
+// IsUnsupportedFeature reports whether err is or wraps an UnsupportedFeatureError
+func IsUnsupportedFeature(err error) bool {
+	var unsupported *UnsupportedFeatureError
+	return errors.As(err, &unsupported)
+}
+
+// unsupportedFeature returns an UnsupportedFeatureError when path is the
+// collection root of a feature, and nil otherwise
+func unsupportedFeature(method, path string) error {
+	root, _, _ := strings.Cut(path, "?")
+	feature, ok := collectionFeatures[root]
+	if !ok {
+		return nil
+	}
+
+	return &UnsupportedFeatureError{Feature: feature, Method: method, Path: root}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUnsupportedFeature(t *testing.T) {
+	// A deployment without maintenance windows, and without incident 5
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not found."}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token", server.URL)
+	if err != nil {
+		t.Fatalf("NewClient() unexpected error: %v", err)
+	}
+
+	_, err = c.CreateMaintenanceWindow(context.Background(), &MaintenanceWindow{Name: "upgrade"})
+	if !IsUnsupportedFeature(err) {
+		t.Fatalf("CreateMaintenanceWindow() error = %v, want an UnsupportedFeatureError", err)
+	}
+	if IsNotFound(err) {
+		t.Errorf("CreateMaintenanceWindow() error is reported as not found: %v", err)
+	}
+	if !strings.Contains(err.Error(), "does not support maintenance windows") {
+		t.Errorf("CreateMaintenanceWindow() error = %q, want it to name the unsupported feature", err)
+	}
+
+	_, err = c.ListIncidents(context.Background(), 0)
+	if !IsUnsupportedFeature(err) {
+		t.Errorf("ListIncidents() error = %v, want an UnsupportedFeatureError", err)
+	}
+
+	_, err = c.GetIncident(context.Background(), 5)
+	if IsUnsupportedFeature(err) {
+		t.Errorf("GetIncident() error = %v, want a NotFoundError", err)
+	}
+	if !IsNotFound(err) {
+		t.Errorf("GetIncident() error = %v, want a NotFoundError", err)
+	}
+}
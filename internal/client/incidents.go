@@ -14,28 +14,61 @@ type Incident struct {
 	ID                  *int    `json:"id,omitempty"`
 	ProjectID           *int    `json:"project_id,omitempty"`
 	Title               string  `json:"title"`
-	Slug                string  `json:"slug"`
+	Slug                string  `json:"slug,omitempty"`
 	Impact              string  `json:"impact"`
 	State               string  `json:"state"`
 	Description         string  `json:"description"`
 	ExcludeFromDowntime bool    `json:"exclude_from_downtime"`
-	Status              string  `json:"status"`
+	MonitorIDs          []int   `json:"monitor_ids"`
+	Status              string  `json:"status,omitempty"`
 	IncidentAt          string  `json:"incident_at"`
 	RecoveryAt          *string `json:"recovery_at,omitempty"`
 	CreatedAt           *string `json:"created_at,omitempty"`
 	UpdatedAt           *string `json:"updated_at,omitempty"`
 }
 
-// IncidentListResponse represents the response from listing incidents
-type IncidentListResponse struct {
-	Data []Incident `json:"data"`
+// IncidentUpdate represents an entry of an incident timeline
+type IncidentUpdate struct {
+	ID          *int    `json:"id,omitempty"`
+	State       string  `json:"state"`
+	Body        string  `json:"body"`
+	PublishedAt *string `json:"published_at,omitempty"`
+	CreatedAt   *string `json:"created_at,omitempty"`
 }
 
+// incidentNullableFields lists the optional incident fields that are sent as
+// explicit nulls on update. There are none: project_id and recovery_at are
+// managed by Phare and the other fields are always sent.
+var incidentNullableFields = []string{}
+
+// incidentUpdateNullableFields lists the optional timeline entry fields that
+// are sent as explicit nulls on update. There are none: published_at keeps
+// its prior value when it is removed from the configuration.
+var incidentUpdateNullableFields = []string{}
+
+// IncidentListResponse represents the response from listing incidents
+type IncidentListResponse = ListResponse[Incident]
+
 // IncidentResponse represents the response from getting an incident
 type IncidentResponse struct {
 	Data Incident `json:"data"`
 }
 
+// CreateIncident creates a new incident
+func (c *Client) CreateIncident(ctx context.Context, incident *Incident) (*Incident, error) {
+	respBody, err := c.doRequest(ctx, "POST", "/uptime/incidents", incident)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create incident: %w", err)
+	}
+
+	var created Incident
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &created, nil
+}
+
 // GetIncident retrieves an incident by ID
 func (c *Client) GetIncident(ctx context.Context, id int) (*Incident, error) {
 	respBody, err := c.doRequest(ctx, "GET", fmt.Sprintf("/uptime/incidents/%d", id), nil)
@@ -51,6 +84,26 @@ func (c *Client) GetIncident(ctx context.Context, id int) (*Incident, error) {
 	return &incident, nil
 }
 
+// UpdateIncident updates an existing incident
+func (c *Client) UpdateIncident(ctx context.Context, id int, incident *Incident) (*Incident, error) {
+	payload, err := updatePayload(incident, incidentNullableFields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update incident: %w", err)
+	}
+
+	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/uptime/incidents/%d", id), payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update incident: %w", err)
+	}
+
+	var updated Incident
+	if err := json.Unmarshal(respBody, &updated); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &updated, nil
+}
+
 // DeleteIncident deletes an incident
 func (c *Client) DeleteIncident(ctx context.Context, id int) error {
 	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/uptime/incidents/%d", id), nil)
@@ -61,17 +114,69 @@ func (c *Client) DeleteIncident(ctx context.Context, id int) error {
 	return nil
 }
 
-// ListIncidents lists all incidents
-func (c *Client) ListIncidents(ctx context.Context) ([]Incident, error) {
-	respBody, err := c.doRequest(ctx, "GET", "/uptime/incidents", nil)
+// ListIncidents lists incidents, following the pagination cursor until limit
+// incidents have been read or the last page is reached. A limit of 0 or less
+// lists all incidents.
+func (c *Client) ListIncidents(ctx context.Context, limit int) ([]Incident, error) {
+	incidents, err := listAll[Incident](ctx, c, "/uptime/incidents", limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list incidents: %w", err)
 	}
 
-	var resp IncidentListResponse
-	if err := json.Unmarshal(respBody, &resp); err != nil {
+	return incidents, nil
+}
+
+// ListIncidentUpdates lists the timeline entries of an incident, oldest first
+func (c *Client) ListIncidentUpdates(ctx context.Context, incidentID int) ([]IncidentUpdate, error) {
+	updates, err := listAll[IncidentUpdate](ctx, c, fmt.Sprintf("/uptime/incidents/%d/updates", incidentID), 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incident updates: %w", err)
+	}
+
+	return updates, nil
+}
+
+// CreateIncidentUpdate posts a new entry on the timeline of an incident
+func (c *Client) CreateIncidentUpdate(ctx context.Context, incidentID int, update *IncidentUpdate) (*IncidentUpdate, error) {
+	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/uptime/incidents/%d/updates", incidentID), update)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create incident update: %w", err)
+	}
+
+	var created IncidentUpdate
+	if err := json.Unmarshal(respBody, &created); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	return resp.Data, nil
+	return &created, nil
+}
+
+// UpdateIncidentUpdate updates an entry of the timeline of an incident
+func (c *Client) UpdateIncidentUpdate(ctx context.Context, incidentID, id int, update *IncidentUpdate) (*IncidentUpdate, error) {
+	payload, err := updatePayload(update, incidentUpdateNullableFields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update incident update: %w", err)
+	}
+
+	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/uptime/incidents/%d/updates/%d", incidentID, id), payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update incident update: %w", err)
+	}
+
+	var updated IncidentUpdate
+	if err := json.Unmarshal(respBody, &updated); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteIncidentUpdate deletes an entry of the timeline of an incident
+func (c *Client) DeleteIncidentUpdate(ctx context.Context, incidentID, id int) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/uptime/incidents/%d/updates/%d", incidentID, id), nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete incident update: %w", err)
+	}
+
+	return nil
 }
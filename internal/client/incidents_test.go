@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListIncidentsPagination(t *testing.T) {
+	// Three pages of two incidents, linked by cursor
+	pages := map[string]string{
+		"":   `{"data": [{"id": 1, "title": "a"}, {"id": 2, "title": "b"}], "meta": {"next_cursor": "c2"}}`,
+		"c2": `{"data": [{"id": 3, "title": "c"}, {"id": 4, "title": "d"}], "meta": {"next_cursor": "c3"}}`,
+		"c3": `{"data": [{"id": 5, "title": "e"}, {"id": 6, "title": "f"}], "meta": {"next_cursor": null}}`,
+	}
+
+	tests := []struct {
+		name         string
+		limit        int
+		wantIDs      []int
+		wantRequests int
+	}{
+		{
+			name:         "no limit reads every page",
+			limit:        0,
+			wantIDs:      []int{1, 2, 3, 4, 5, 6},
+			wantRequests: 3,
+		},
+		{
+			name:         "limit within first page",
+			limit:        1,
+			wantIDs:      []int{1},
+			wantRequests: 1,
+		},
+		{
+			name:         "limit across pages",
+			limit:        3,
+			wantIDs:      []int{1, 2, 3},
+			wantRequests: 2,
+		},
+		{
+			name:         "limit above total",
+			limit:        100,
+			wantIDs:      []int{1, 2, 3, 4, 5, 6},
+			wantRequests: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.URL.Path != "/uptime/incidents" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				body, ok := pages[r.URL.Query().Get("cursor")]
+				if !ok {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = w.Write([]byte(`{"message": "invalid cursor"}`))
+					return
+				}
+				_, _ = w.Write([]byte(body))
+			}))
+			defer server.Close()
+
+			c, err := NewClient("test-token", server.URL)
+			if err != nil {
+				t.Fatalf("NewClient() unexpected error: %v", err)
+			}
+
+			incidents, err := c.ListIncidents(context.Background(), tt.limit)
+			if err != nil {
+				t.Fatalf("ListIncidents() unexpected error: %v", err)
+			}
+
+			if len(incidents) != len(tt.wantIDs) {
+				t.Fatalf("ListIncidents() returned %d incidents, want %d", len(incidents), len(tt.wantIDs))
+			}
+			for i, incident := range incidents {
+				if incident.ID == nil || *incident.ID != tt.wantIDs[i] {
+					t.Errorf("incidents[%d].ID = %v, want %d", i, incident.ID, tt.wantIDs[i])
+				}
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestCreateIncidentOmitsServerFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/uptime/incidents" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		for _, key := range []string{"id", "slug", "recovery_at"} {
+			if _, ok := payload[key]; ok {
+				t.Errorf("payload contains server-managed field %q", key)
+			}
+		}
+		// An unset status is left for Phare to derive from the state
+		if _, ok := payload["status"]; ok {
+			t.Errorf("payload contains unset field %q", "status")
+		}
+
+		_, _ = w.Write([]byte(`{"id": 1, "title": "Outage", "slug": "outage", "status": "ongoing"}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token", server.URL)
+	if err != nil {
+		t.Fatalf("NewClient() unexpected error: %v", err)
+	}
+
+	created, err := c.CreateIncident(context.Background(), &Incident{
+		Title:      "Outage",
+		Impact:     "majorOutage",
+		State:      "investigating",
+		IncidentAt: "2024-01-01T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatalf("CreateIncident() unexpected error: %v", err)
+	}
+	if created.ID == nil || *created.ID != 1 || created.Status != "ongoing" {
+		t.Errorf("CreateIncident() = %+v, want the created incident", created)
+	}
+}
+
+func TestUpdateIncidentPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/uptime/incidents/1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		// A cleared description is sent as an empty string
+		if description, ok := payload["description"]; !ok || description != "" {
+			t.Errorf("payload description = %v, want empty string", payload["description"])
+		}
+		if payload["status"] != "resolved" {
+			t.Errorf("payload status = %v, want resolved", payload["status"])
+		}
+		// Fields managed by Phare must not be cleared
+		for _, key := range []string{"project_id", "recovery_at"} {
+			if _, ok := payload[key]; ok {
+				t.Errorf("payload contains server-managed field %q", key)
+			}
+		}
+
+		_, _ = w.Write([]byte(`{"id": 1, "title": "Outage", "project_id": 3}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token", server.URL)
+	if err != nil {
+		t.Fatalf("NewClient() unexpected error: %v", err)
+	}
+
+	updated, err := c.UpdateIncident(context.Background(), 1, &Incident{
+		Title:      "Outage",
+		Impact:     "majorOutage",
+		State:      "monitoring",
+		Status:     "resolved",
+		IncidentAt: "2024-01-01T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatalf("UpdateIncident() unexpected error: %v", err)
+	}
+	if updated.ProjectID == nil || *updated.ProjectID != 3 {
+		t.Errorf("UpdateIncident() = %+v, want the updated incident", updated)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Integration represents a Phare notification integration
+type Integration struct {
+	ID        *int                 `json:"id,omitempty"`
+	Name      string               `json:"name"`
+	Type      string               `json:"type"`
+	Settings  *IntegrationSettings `json:"settings,omitempty"`
+	Healthy   bool                 `json:"healthy,omitempty"`
+	ProjectID *int                 `json:"project_id,omitempty"`
+	CreatedAt *string              `json:"created_at,omitempty"`
+	UpdatedAt *string              `json:"updated_at,omitempty"`
+}
+
+// IntegrationSettings holds the type-specific settings of an integration. The
+// API never returns the secret settings, they are write-only.
+type IntegrationSettings struct {
+	URL        *string `json:"url,omitempty"`
+	Secret     *string `json:"secret,omitempty"`
+	WebhookURL *string `json:"webhook_url,omitempty"`
+	Email      *string `json:"email,omitempty"`
+}
+
+// integrationNullableFields lists the optional integration fields that are
+// sent as explicit nulls on update so removing them clears them.
+var integrationNullableFields = []string{"project_id", "settings.secret"}
+
+// IntegrationListResponse represents the response from listing integrations
+type IntegrationListResponse = ListResponse[Integration]
+
+// IntegrationResponse represents the response from getting an integration
+type IntegrationResponse struct {
+	Data Integration `json:"data"`
+}
+
+// CreateIntegration creates a new integration
+func (c *Client) CreateIntegration(ctx context.Context, integration *Integration) (*Integration, error) {
+	respBody, err := c.doRequest(ctx, "POST", "/integrations", integration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create integration: %w", err)
+	}
+
+	var created Integration
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetIntegration retrieves an integration by ID
+func (c *Client) GetIntegration(ctx context.Context, id int) (*Integration, error) {
+	respBody, err := c.doRequest(ctx, "GET", fmt.Sprintf("/integrations/%d", id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get integration: %w", err)
+	}
+
+	var integration Integration
+	if err := json.Unmarshal(respBody, &integration); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &integration, nil
+}
+
+// UpdateIntegration updates an existing integration
+func (c *Client) UpdateIntegration(ctx context.Context, id int, integration *Integration) (*Integration, error) {
+	payload, err := updatePayload(integration, integrationNullableFields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update integration: %w", err)
+	}
+
+	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/integrations/%d", id), payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update integration: %w", err)
+	}
+
+	var updated Integration
+	if err := json.Unmarshal(respBody, &updated); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	c.forgetIntegration(id)
+
+	return &updated, nil
+}
+
+// DeleteIntegration deletes an integration
+func (c *Client) DeleteIntegration(ctx context.Context, id int) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/integrations/%d", id), nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete integration: %w", err)
+	}
+
+	c.forgetIntegration(id)
+
+	return nil
+}
+
+// ListIntegrations lists all integrations, following the pagination cursor
+func (c *Client) ListIntegrations(ctx context.Context) ([]Integration, error) {
+	integrations, err := listAll[Integration](ctx, c, "/integrations", 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list integrations: %w", err)
+	}
+
+	return integrations, nil
+}
+
+// LookupIntegration retrieves an integration by ID, caching the result for the
+// lifetime of the client so repeated lookups across resources make one call
+func (c *Client) LookupIntegration(ctx context.Context, id int) (*Integration, error) {
+	c.integrationsMu.Lock()
+	cached, ok := c.integrations[id]
+	c.integrationsMu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	integration, err := c.GetIntegration(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	c.integrationsMu.Lock()
+	if c.integrations == nil {
+		c.integrations = map[int]*Integration{}
+	}
+	c.integrations[id] = integration
+	c.integrationsMu.Unlock()
+
+	return integration, nil
+}
+
+// forgetIntegration drops an integration from the lookup cache after it was
+// modified through this client
+func (c *Client) forgetIntegration(id int) {
+	c.integrationsMu.Lock()
+	delete(c.integrations, id)
+	c.integrationsMu.Unlock()
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLookupIntegrationCachesResults(t *testing.T) {
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls[r.URL.Path]++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1, "name": "Ops Slack", "type": "slack"}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("token", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		integration, err := c.LookupIntegration(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if integration.Name != "Ops Slack" || integration.Type != "slack" {
+			t.Errorf("unexpected integration: %+v", integration)
+		}
+	}
+
+	if calls["/integrations/1"] != 1 {
+		t.Errorf("expected 1 API call, got %d", calls["/integrations/1"])
+	}
+}
+
+func TestUpdateIntegration(t *testing.T) {
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls[r.Method]++
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost {
+			var payload map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+			settings, _ := payload["settings"].(map[string]any)
+			if secret, ok := settings["secret"]; !ok || secret != nil {
+				t.Errorf("payload settings.secret = %v, want explicit null", settings["secret"])
+			}
+			if _, ok := payload["healthy"]; ok {
+				t.Errorf("payload contains server-managed field %q", "healthy")
+			}
+		}
+
+		_, _ = w.Write([]byte(`{"id": 1, "name": "Deploy hook", "type": "webhook", "settings": {"url": "https://example.com/hook"}}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("token", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if _, err := c.LookupIntegration(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updated, err := c.UpdateIntegration(context.Background(), 1, &Integration{
+		Name:     "Deploy hook",
+		Type:     "webhook",
+		Settings: &IntegrationSettings{URL: stringPtr("https://example.com/hook")},
+	})
+	if err != nil {
+		t.Fatalf("UpdateIntegration() unexpected error: %v", err)
+	}
+	if updated.Settings == nil || updated.Settings.URL == nil || *updated.Settings.URL != "https://example.com/hook" {
+		t.Errorf("UpdateIntegration() = %+v, want the updated integration", updated)
+	}
+
+	// The update must invalidate the cached integration
+	if _, err := c.LookupIntegration(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls[http.MethodGet] != 2 {
+		t.Errorf("expected 2 GET calls, got %d", calls[http.MethodGet])
+	}
+}
@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// TestJSONTags pins the JSON keys of the API payloads, so that a mistyped or
+// renamed tag is caught before it silently drops a field
+func TestJSONTags(t *testing.T) {
+	id := 1
+	paused := true
+	uptime := 99.9
+
+	tests := []struct {
+		name  string
+		value any
+		keys  []string
+	}{
+		{
+			name: "Monitor",
+			value: &Monitor{
+				ID:                    &id,
+				Name:                  "api",
+				Protocol:              "http",
+				Interval:              60,
+				Timeout:               5000,
+				IncidentConfirmations: 1,
+				RecoveryConfirmations: 1,
+				Regions:               []string{"na-usa-iad"},
+				SuccessAssertions:     []SuccessAssertion{{Type: "status_code"}},
+				Paused:                &paused,
+				Status:                stringPtr("up"),
+				ResolvedIPFamily:      stringPtr("ipv4"),
+				PingURL:               stringPtr("https://ping.example.com/token"),
+				CreatedAt:             stringPtr("2024-01-01T00:00:00Z"),
+				UpdatedAt:             stringPtr("2024-01-02T00:00:00Z"),
+			},
+			keys: []string{
+				"created_at", "id", "incident_confirmations", "interval", "name", "paused", "ping_url", "protocol",
+				"recovery_confirmations", "regions", "request", "resolved_ip_family", "status",
+				"success_assertions", "timeout", "updated_at",
+			},
+		},
+		{
+			name: "MonitorRequest",
+			value: &MonitorRequest{
+				Method:          stringPtr("GET"),
+				URL:             stringPtr("https://example.com"),
+				TLSSkipVerify:   &paused,
+				TLSMinVersion:   stringPtr("1.2"),
+				TLSServerName:   stringPtr("example.com"),
+				Body:            stringPtr("{}"),
+				FollowRedirects: &paused,
+				HTTPVersion:     stringPtr("2"),
+				UserAgentSecret: stringPtr("secret"),
+				Headers:         []RequestHeader{{Name: "Accept", Value: "*/*"}},
+				Host:            stringPtr("example.com"),
+				Port:            stringPtr("443"),
+				Connection:      stringPtr("tls"),
+				RecordType:      stringPtr("A"),
+				Resolver:        stringPtr("1.1.1.1"),
+				Period:          &id,
+				Grace:           &id,
+			},
+			keys: []string{
+				"body", "connection", "follow_redirects", "grace", "headers", "host", "http_version", "method", "period", "port",
+				"record_type", "resolver", "tls_min_version", "tls_server_name", "tls_skip_verify", "url", "user_agent_secret",
+			},
+		},
+		{
+			name:  "RequestHeader",
+			value: &RequestHeader{Name: "Accept", Value: "*/*"},
+			keys:  []string{"name", "value"},
+		},
+		{
+			name: "SuccessAssertion",
+			value: &SuccessAssertion{
+				Type:     "response_header",
+				Operator: stringPtr("equals"),
+				Value:    stringPtr("application/json"),
+				Property: stringPtr("Content-Type"),
+			},
+			keys: []string{"operator", "property", "type", "value"},
+		},
+		{
+			name: "Project",
+			value: &Project{
+				ID:          &id,
+				Name:        "Platform",
+				Slug:        stringPtr("platform"),
+				Description: stringPtr("Platform services"),
+				CreatedAt:   stringPtr("2024-01-01T00:00:00Z"),
+				UpdatedAt:   stringPtr("2024-01-02T00:00:00Z"),
+			},
+			keys: []string{"created_at", "description", "id", "name", "slug", "updated_at"},
+		},
+		{
+			name:  "MonitorStats",
+			value: &MonitorStats{UptimePercentage: &uptime, AverageResponseTime: &uptime},
+			keys:  []string{"average_response_time", "uptime_percentage"},
+		},
+		{
+			name: "MonitorWithRelated",
+			value: &MonitorWithRelated{
+				Monitor:   Monitor{Name: "api", Protocol: "http", Regions: []string{"na-usa-iad"}},
+				Stats:     &MonitorStats{UptimePercentage: &uptime},
+				Incidents: []Incident{{Title: "Outage"}},
+				LastCheck: &MonitorCheck{},
+			},
+			keys: []string{
+				"incident_confirmations", "incidents", "interval", "last_check", "name", "protocol",
+				"recovery_confirmations", "regions", "request", "stats", "timeout",
+			},
+		},
+		{
+			name: "MonitorCheck",
+			value: &MonitorCheck{
+				CheckedAt:        stringPtr("2024-01-01T00:00:00Z"),
+				AssertionResults: []CheckAssertionResult{{Type: "status_code", Passed: true}},
+			},
+			keys: []string{"assertion_results", "checked_at"},
+		},
+		{
+			name:  "CheckAssertionResult",
+			value: &CheckAssertionResult{Type: "status_code", Passed: false, Actual: stringPtr("503")},
+			keys:  []string{"actual", "passed", "type"},
+		},
+		{
+			name: "StatusPage",
+			value: &StatusPage{
+				ID:                  &id,
+				Name:                "public",
+				Title:               "Status",
+				Description:         "Current status",
+				SearchEngineIndexed: true,
+				WebsiteURL:          "https://example.com",
+				Subdomain:           stringPtr("status-example"),
+				Domain:              stringPtr("status.example.com"),
+				Timeframe:           &id,
+				Components:          []StatusComponent{{ComponentableType: "uptime/monitor", ComponentableID: 1}},
+				Logo:                stringPtr("https://example.com/logo.png"),
+				Favicon:             stringPtr("https://example.com/favicon.ico"),
+				CreatedAt:           stringPtr("2024-01-01T00:00:00Z"),
+				UpdatedAt:           stringPtr("2024-01-02T00:00:00Z"),
+			},
+			keys: []string{
+				"colors", "components", "created_at", "description", "domain", "favicon", "id", "logo", "name",
+				"search_engine_indexed", "subdomain", "timeframe", "title", "updated_at", "website_url",
+			},
+		},
+		{
+			// The API expects the colors in camelCase
+			name: "StatusPageColors",
+			value: &StatusPageColors{
+				Operational:         "#16a34a",
+				DegradedPerformance: "#fbbf24",
+				PartialOutage:       "#f59e0b",
+				MajorOutage:         "#ef4444",
+				Maintenance:         "#6366f1",
+				Empty:               "#d3d3d3",
+			},
+			keys: []string{"degradedPerformance", "empty", "maintenance", "majorOutage", "operational", "partialOutage"},
+		},
+		{
+			name:  "StatusComponent",
+			value: &StatusComponent{ComponentableType: "uptime/monitor", ComponentableID: 1},
+			keys:  []string{"componentable_id", "componentable_type"},
+		},
+		{
+			name: "AlertRule",
+			value: &AlertRule{
+				ID:                 &id,
+				Event:              "monitor_created",
+				IntegrationID:      1,
+				RateLimit:          5,
+				EventSettings:      AlertEventSettings{Type: "all"},
+				EscalationPolicyID: &id,
+				ProjectID:          &id,
+				CreatedAt:          stringPtr("2024-01-01T00:00:00Z"),
+				UpdatedAt:          stringPtr("2024-01-02T00:00:00Z"),
+			},
+			keys: []string{
+				"created_at", "escalation_policy_id", "event", "event_settings", "id", "integration_id",
+				"project_id", "rate_limit", "updated_at",
+			},
+		},
+		{
+			name:  "AlertEventSettings",
+			value: &AlertEventSettings{Type: "all"},
+			keys:  []string{"type"},
+		},
+		{
+			name: "EscalationPolicy",
+			value: &EscalationPolicy{
+				ID:        &id,
+				Name:      "on-call",
+				Steps:     []EscalationStep{{Delay: 5, IntegrationID: 1}},
+				ProjectID: &id,
+				CreatedAt: stringPtr("2024-01-01T00:00:00Z"),
+				UpdatedAt: stringPtr("2024-01-02T00:00:00Z"),
+			},
+			keys: []string{"created_at", "id", "name", "project_id", "steps", "updated_at"},
+		},
+		{
+			name:  "EscalationStep",
+			value: &EscalationStep{Delay: 5, IntegrationID: 1},
+			keys:  []string{"delay", "integration_id"},
+		},
+		{
+			name: "Integration",
+			value: &Integration{
+				ID:        &id,
+				Name:      "slack",
+				Type:      "slack",
+				Settings:  &IntegrationSettings{WebhookURL: stringPtr("https://hooks.slack.com/services/T0/B0/X")},
+				Healthy:   true,
+				ProjectID: &id,
+				CreatedAt: stringPtr("2024-01-01T00:00:00Z"),
+				UpdatedAt: stringPtr("2024-01-02T00:00:00Z"),
+			},
+			keys: []string{"created_at", "healthy", "id", "name", "project_id", "settings", "type", "updated_at"},
+		},
+		{
+			name: "IntegrationSettings",
+			value: &IntegrationSettings{
+				URL:        stringPtr("https://example.com/hook"),
+				Secret:     stringPtr("secret"),
+				WebhookURL: stringPtr("https://hooks.slack.com/services/T0/B0/X"),
+				Email:      stringPtr("ops@example.com"),
+			},
+			keys: []string{"email", "secret", "url", "webhook_url"},
+		},
+		{
+			name: "Incident",
+			value: &Incident{
+				ID:                  &id,
+				ProjectID:           &id,
+				Title:               "Outage",
+				Slug:                "outage",
+				Impact:              "major_outage",
+				State:               "investigating",
+				Description:         "API unavailable",
+				ExcludeFromDowntime: true,
+				MonitorIDs:          []int{id},
+				Status:              "ongoing",
+				IncidentAt:          "2024-01-01T00:00:00Z",
+				RecoveryAt:          stringPtr("2024-01-01T01:00:00Z"),
+				CreatedAt:           stringPtr("2024-01-01T00:00:00Z"),
+				UpdatedAt:           stringPtr("2024-01-02T00:00:00Z"),
+			},
+			keys: []string{
+				"created_at", "description", "exclude_from_downtime", "id", "impact", "incident_at", "monitor_ids",
+				"project_id", "recovery_at", "slug", "state", "status", "title", "updated_at",
+			},
+		},
+		{
+			name: "IncidentUpdate",
+			value: &IncidentUpdate{
+				ID:          &id,
+				State:       "identified",
+				Body:        "The faulty deployment was rolled back.",
+				PublishedAt: stringPtr("2024-01-01T00:30:00Z"),
+				CreatedAt:   stringPtr("2024-01-01T00:30:00Z"),
+			},
+			keys: []string{"body", "created_at", "id", "published_at", "state"},
+		},
+		{
+			name: "MaintenanceWindow",
+			value: &MaintenanceWindow{
+				ID:           &id,
+				Name:         "Database upgrade",
+				Description:  stringPtr("Primary database upgrade"),
+				MonitorIDs:   []int{1, 2},
+				StartsAt:     "2024-01-01T00:00:00Z",
+				EndsAt:       "2024-01-01T02:00:00Z",
+				StatusPageID: &id,
+				ProjectID:    &id,
+				CreatedAt:    stringPtr("2024-01-01T00:00:00Z"),
+				UpdatedAt:    stringPtr("2024-01-02T00:00:00Z"),
+			},
+			keys: []string{
+				"created_at", "description", "ends_at", "id", "monitor_ids", "name", "project_id", "starts_at",
+				"status_page_id", "updated_at",
+			},
+		},
+		{
+			name: "StatusPageIncident",
+			value: &StatusPageIncident{
+				ID:          &id,
+				IncidentID:  &id,
+				Title:       "Degraded API",
+				Body:        "We are **investigating** slow responses.",
+				Impact:      "degradedPerformance",
+				PublishedAt: stringPtr("2024-01-01T00:00:00Z"),
+				CreatedAt:   stringPtr("2024-01-01T00:00:00Z"),
+				UpdatedAt:   stringPtr("2024-01-02T00:00:00Z"),
+			},
+			keys: []string{"body", "created_at", "id", "impact", "incident_id", "published_at", "title", "updated_at"},
+		},
+		{
+			name:  "ListMeta",
+			value: &ListMeta{NextCursor: stringPtr("abc")},
+			keys:  []string{"next_cursor"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("failed to marshal: %v", err)
+			}
+
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(data, &fields); err != nil {
+				t.Fatalf("failed to unmarshal keys: %v", err)
+			}
+
+			keys := make([]string, 0, len(fields))
+			for key := range fields {
+				keys = append(keys, key)
+			}
+			slices.Sort(keys)
+			if !slices.Equal(keys, tt.keys) {
+				t.Errorf("keys = %v, want %v", keys, tt.keys)
+			}
+
+			// Decoding the payload back must restore every field
+			decoded := reflect.New(reflect.TypeOf(tt.value).Elem()).Interface()
+			if err := json.Unmarshal(data, decoded); err != nil {
+				t.Fatalf("failed to unmarshal: %v", err)
+			}
+			if !reflect.DeepEqual(decoded, tt.value) {
+				t.Errorf("round trip = %+v, want %+v", decoded, tt.value)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// MaintenanceWindow represents a Phare scheduled maintenance window, during
+// which alerting is suppressed for its monitors
+type MaintenanceWindow struct {
+	ID           *int    `json:"id,omitempty"`
+	Name         string  `json:"name"`
+	Description  *string `json:"description,omitempty"`
+	MonitorIDs   []int   `json:"monitor_ids"`
+	StartsAt     string  `json:"starts_at"`
+	EndsAt       string  `json:"ends_at"`
+	StatusPageID *int    `json:"status_page_id,omitempty"`
+	ProjectID    *int    `json:"project_id,omitempty"`
+	CreatedAt    *string `json:"created_at,omitempty"`
+	UpdatedAt    *string `json:"updated_at,omitempty"`
+}
+
+// maintenanceWindowNullableFields lists the optional maintenance window fields
+// that are sent as explicit nulls on update so removing them clears them.
+var maintenanceWindowNullableFields = []string{"description", "status_page_id"}
+
+// MaintenanceWindowResponse represents the response from creating/getting a
+// maintenance window
+type MaintenanceWindowResponse struct {
+	Data MaintenanceWindow `json:"data"`
+}
+
+// CreateMaintenanceWindow creates a new maintenance window
+func (c *Client) CreateMaintenanceWindow(ctx context.Context, window *MaintenanceWindow) (*MaintenanceWindow, error) {
+	respBody, err := c.doRequest(ctx, "POST", "/uptime/maintenance-windows", window)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create maintenance window: %w", err)
+	}
+
+	var created MaintenanceWindow
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetMaintenanceWindow retrieves a maintenance window by ID
+func (c *Client) GetMaintenanceWindow(ctx context.Context, id int) (*MaintenanceWindow, error) {
+	respBody, err := c.doRequest(ctx, "GET", fmt.Sprintf("/uptime/maintenance-windows/%d", id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maintenance window: %w", err)
+	}
+
+	var window MaintenanceWindow
+	if err := json.Unmarshal(respBody, &window); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &window, nil
+}
+
+// UpdateMaintenanceWindow updates an existing maintenance window
+func (c *Client) UpdateMaintenanceWindow(ctx context.Context, id int, window *MaintenanceWindow) (*MaintenanceWindow, error) {
+	payload, err := updatePayload(window, maintenanceWindowNullableFields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update maintenance window: %w", err)
+	}
+
+	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/uptime/maintenance-windows/%d", id), payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update maintenance window: %w", err)
+	}
+
+	var updated MaintenanceWindow
+	if err := json.Unmarshal(respBody, &updated); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window
+func (c *Client) DeleteMaintenanceWindow(ctx context.Context, id int) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/uptime/maintenance-windows/%d", id), nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete maintenance window: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// maintenanceMaxRetries is the number of times a request is retried while
+	// the API reports planned maintenance.
+	maintenanceMaxRetries = 5
+
+	// maintenanceBaseDelay is the initial wait between maintenance retries when
+	// the API does not send a Retry-After header.
+	maintenanceBaseDelay = 5 * time.Second
+
+	// maintenanceMaxDelay caps the wait between maintenance retries. Maintenance
+	// windows outlast transient errors, so the cap is deliberately generous.
+	maintenanceMaxDelay = 2 * time.Minute
+)
+
+// maintenanceMarker is present in the body of 503 responses returned while
+// Phare is deploying.
+var maintenanceMarker = []byte("maintenance")
+
+// isMaintenanceResponse reports whether the response signals planned
+// maintenance rather than an unexpected server error
+func isMaintenanceResponse(statusCode int, respBody []byte) bool {
+	return statusCode == http.StatusServiceUnavailable &&
+		bytes.Contains(bytes.ToLower(respBody), maintenanceMarker)
+}
+
+// maintenanceRetryDelay returns how long to wait before retrying a request
+// rejected for maintenance. The Retry-After header, as delay seconds or an
+// HTTP date, takes precedence over exponential backoff.
+func maintenanceRetryDelay(retryAfter string, attempt int) time.Duration {
+	delay := maintenanceBaseDelay << attempt
+	if d, ok := parseRetryAfter(retryAfter); ok {
+		delay = d
+	}
+
+	return min(delay, maintenanceMaxDelay)
+}
+
+// parseRetryAfter parses a Retry-After header given as delay seconds or an
+// HTTP date
+func parseRetryAfter(retryAfter string) (time.Duration, bool) {
+	if retryAfter == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(retryAfter); err == nil {
+		return max(time.Until(at), 0), true
+	}
+
+	return 0, false
+}
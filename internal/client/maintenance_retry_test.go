@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDoRequestMaintenanceRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		firstBody    string
+		wantRequests int
+		wantError    bool
+	}{
+		{
+			name:         "maintenance response is retried",
+			firstBody:    `{"message": "Phare is under maintenance, please retry shortly"}`,
+			wantRequests: 2,
+			wantError:    false,
+		},
+		{
+			name:         "other service unavailable response is not retried",
+			firstBody:    `{"message": "Service Unavailable"}`,
+			wantRequests: 1,
+			wantError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests == 1 {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusServiceUnavailable)
+					_, _ = w.Write([]byte(tt.firstBody))
+					return
+				}
+				_, _ = w.Write([]byte(`{"id": 1}`))
+			}))
+			defer server.Close()
+
+			c, err := NewClient("test-token", server.URL, WithMaxRetries(0))
+			if err != nil {
+				t.Fatalf("NewClient() unexpected error: %v", err)
+			}
+
+			_, err = c.doRequest(context.Background(), "GET", "/uptime/monitors/1", nil)
+			if tt.wantError && err == nil {
+				t.Error("doRequest() expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("doRequest() unexpected error: %v", err)
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("doRequest() made %d requests, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestMaintenanceRetryDelay(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter string
+		attempt    int
+		want       time.Duration
+	}{
+		{
+			name:       "retry after seconds",
+			retryAfter: "30",
+			attempt:    0,
+			want:       30 * time.Second,
+		},
+		{
+			name:       "exponential backoff without header",
+			retryAfter: "",
+			attempt:    2,
+			want:       4 * maintenanceBaseDelay,
+		},
+		{
+			name:       "capped at maximum delay",
+			retryAfter: "3600",
+			attempt:    0,
+			want:       maintenanceMaxDelay,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maintenanceRetryDelay(tt.retryAfter, tt.attempt); got != tt.want {
+				t.Errorf("maintenanceRetryDelay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpdateMaintenanceWindow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/uptime/maintenance-windows/1" {
+			t.Errorf("request = %s %s, want POST /uptime/maintenance-windows/1", r.Method, r.URL.Path)
+		}
+
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		for _, field := range maintenanceWindowNullableFields {
+			if value, ok := payload[field]; !ok || value != nil {
+				t.Errorf("payload %s = %v, want explicit null", field, payload[field])
+			}
+		}
+		if payload["starts_at"] != "2024-06-01T22:00:00Z" || payload["ends_at"] != "2024-06-02T00:00:00Z" {
+			t.Errorf("payload starts_at = %v, ends_at = %v, want the configured window", payload["starts_at"], payload["ends_at"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1, "name": "Database upgrade", "monitor_ids": [2], "starts_at": "2024-06-01T22:00:00Z", "ends_at": "2024-06-02T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("token", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	updated, err := c.UpdateMaintenanceWindow(context.Background(), 1, &MaintenanceWindow{
+		Name:       "Database upgrade",
+		MonitorIDs: []int{2},
+		StartsAt:   "2024-06-01T22:00:00Z",
+		EndsAt:     "2024-06-02T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatalf("UpdateMaintenanceWindow() unexpected error: %v", err)
+	}
+	if updated.StatusPageID != nil {
+		t.Errorf("UpdateMaintenanceWindow() status_page_id = %d, want unset", *updated.StatusPageID)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strings"
 )
 
 // Monitor represents a Phare uptime monitor
@@ -22,6 +24,9 @@ type Monitor struct {
 	Regions               []string           `json:"regions"`
 	SuccessAssertions     []SuccessAssertion `json:"success_assertions,omitempty"`
 	Paused                *bool              `json:"paused,omitempty"`
+	Status                *string            `json:"status,omitempty"` // up, down, paused or pending
+	ResolvedIPFamily      *string            `json:"resolved_ip_family,omitempty"`
+	PingURL               *string            `json:"ping_url,omitempty"` // heartbeat monitors only, contains the secret ping token
 	CreatedAt             *string            `json:"created_at,omitempty"`
 	UpdatedAt             *string            `json:"updated_at,omitempty"`
 }
@@ -32,15 +37,30 @@ type MonitorRequest struct {
 	Method          *string         `json:"method,omitempty"`
 	URL             *string         `json:"url,omitempty"`
 	TLSSkipVerify   *bool           `json:"tls_skip_verify,omitempty"`
+	TLSMinVersion   *string         `json:"tls_min_version,omitempty"`
+	TLSServerName   *string         `json:"tls_server_name,omitempty"`
 	Body            *string         `json:"body,omitempty"`
 	FollowRedirects *bool           `json:"follow_redirects,omitempty"`
+	HTTPVersion     *string         `json:"http_version,omitempty"`
 	UserAgentSecret *string         `json:"user_agent_secret,omitempty"`
 	Headers         []RequestHeader `json:"headers,omitempty"`
 
-	// TCP fields
+	// TCP fields, Host is also used by ICMP and DNS monitors
 	Host       *string `json:"host,omitempty"`
 	Port       *string `json:"port,omitempty"`
 	Connection *string `json:"connection,omitempty"`
+
+	// ICMP fields
+	PacketCount *int `json:"packet_count,omitempty"`
+	PacketSize  *int `json:"packet_size,omitempty"`
+
+	// DNS fields
+	RecordType *string `json:"record_type,omitempty"`
+	Resolver   *string `json:"resolver,omitempty"`
+
+	// Heartbeat fields, in seconds
+	Period *int `json:"period,omitempty"`
+	Grace  *int `json:"grace,omitempty"`
 }
 
 // RequestHeader represents an HTTP header
@@ -49,19 +69,50 @@ type RequestHeader struct {
 	Value string `json:"value"`
 }
 
-// SuccessAssertion represents a success assertion for a monitor
+// SuccessAssertion represents a success assertion for a monitor. A check
+// succeeds only when all of the monitor's assertions pass.
 type SuccessAssertion struct {
 	Type     string  `json:"type"`
 	Operator *string `json:"operator,omitempty"`
+	// Value is sent as a string, including the number of milliseconds of
+	// response_time and of days of certificate_expiry_days assertions
 	Value    *string `json:"value,omitempty"`
 	Property *string `json:"property,omitempty"`
 }
 
-// MonitorListResponse represents the response from listing monitors
-type MonitorListResponse struct {
-	Data []Monitor `json:"data"`
+// monitorNullableFields lists, per protocol, the optional monitor fields that
+// are sent as explicit nulls on update so removing them clears them.
+var monitorNullableFields = map[string][]string{
+	"http": {
+		"success_assertions",
+		"request.body",
+		"request.user_agent_secret",
+		"request.tls_min_version",
+		"request.tls_server_name",
+		"request.http_version",
+		"request.headers",
+	},
+	"tcp": {
+		"success_assertions",
+		"request.tls_min_version",
+		"request.tls_server_name",
+	},
+	"icmp": {
+		"success_assertions",
+		"request.packet_size",
+	},
+	"dns": {
+		"success_assertions",
+		"request.resolver",
+	},
+	"heartbeat": {
+		"success_assertions",
+	},
 }
 
+// MonitorListResponse represents the response from listing monitors
+type MonitorListResponse = ListResponse[Monitor]
+
 // MonitorResponse represents the response from creating/getting a monitor
 type MonitorResponse struct {
 	Data Monitor `json:"data"`
@@ -99,7 +150,12 @@ func (c *Client) GetMonitor(ctx context.Context, id int) (*Monitor, error) {
 
 // UpdateMonitor updates an existing monitor
 func (c *Client) UpdateMonitor(ctx context.Context, id int, monitor *Monitor) (*Monitor, error) {
-	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/uptime/monitors/%d", id), monitor)
+	payload, err := updatePayload(monitor, monitorNullableFields[monitor.Protocol])
+	if err != nil {
+		return nil, fmt.Errorf("failed to update monitor: %w", err)
+	}
+
+	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/uptime/monitors/%d", id), payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update monitor: %w", err)
 	}
@@ -142,17 +198,183 @@ func (c *Client) ResumeMonitor(ctx context.Context, id int) error {
 	return nil
 }
 
-// ListMonitors lists all monitors
+// ListMonitors lists all monitors, following the pagination cursor
 func (c *Client) ListMonitors(ctx context.Context) ([]Monitor, error) {
-	respBody, err := c.doRequest(ctx, "GET", "/uptime/monitors", nil)
+	monitors, err := listAll[Monitor](ctx, c, "/uptime/monitors", 0)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list monitors: %w", err)
 	}
 
-	var resp MonitorListResponse
-	if err := json.Unmarshal(respBody, &resp); err != nil {
+	return monitors, nil
+}
+
+// FindMonitorByName returns the first monitor named name, or nil when none of
+// the first limit monitors has that name. Listing stops at the page holding
+// the monitor. A limit of 0 or less searches all monitors.
+func (c *Client) FindMonitorByName(ctx context.Context, name string, limit int) (*Monitor, error) {
+	var found *Monitor
+	searched := 0
+	err := walkPages(ctx, c, "/uptime/monitors", func(page []Monitor) bool {
+		for i := range page {
+			if limit > 0 && searched >= limit {
+				return false
+			}
+			searched++
+
+			if page[i].Name == name {
+				found = &page[i]
+				return false
+			}
+		}
+		return limit <= 0 || searched < limit
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list monitors: %w", err)
+	}
+
+	return found, nil
+}
+
+// Related resources that can be requested along with a monitor
+const (
+	MonitorIncludeStats     = "stats"
+	MonitorIncludeIncidents = "incidents"
+	MonitorIncludeLastCheck = "last_check"
+)
+
+// MonitorStats represents the uptime statistics of a monitor
+type MonitorStats struct {
+	UptimePercentage    *float64 `json:"uptime_percentage,omitempty"`
+	AverageResponseTime *float64 `json:"average_response_time,omitempty"`
+}
+
+// MonitorCheck represents the latest check performed by a monitor
+type MonitorCheck struct {
+	CheckedAt        *string                `json:"checked_at,omitempty"`
+	AssertionResults []CheckAssertionResult `json:"assertion_results"`
+}
+
+// CheckAssertionResult represents the evaluation of a success assertion
+// during a monitor check
+type CheckAssertionResult struct {
+	Type   string  `json:"type"`
+	Passed bool    `json:"passed"`
+	Actual *string `json:"actual,omitempty"`
+}
+
+// MonitorWithRelated represents a monitor together with its related resources
+type MonitorWithRelated struct {
+	Monitor
+	Stats     *MonitorStats `json:"stats,omitempty"`
+	Incidents []Incident    `json:"incidents,omitempty"`
+	LastCheck *MonitorCheck `json:"last_check,omitempty"`
+}
+
+// GetMonitorStats retrieves the uptime statistics of a monitor
+func (c *Client) GetMonitorStats(ctx context.Context, id int) (*MonitorStats, error) {
+	respBody, err := c.doRequest(ctx, "GET", fmt.Sprintf("/uptime/monitors/%d/stats", id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get monitor stats: %w", err)
+	}
+
+	var stats MonitorStats
+	if err := json.Unmarshal(respBody, &stats); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &stats, nil
+}
+
+// ListMonitorIncidents lists the incidents of a monitor, following the
+// pagination cursor
+func (c *Client) ListMonitorIncidents(ctx context.Context, id int) ([]Incident, error) {
+	incidents, err := listAll[Incident](ctx, c, fmt.Sprintf("/uptime/monitors/%d/incidents", id), 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list monitor incidents: %w", err)
+	}
+
+	return incidents, nil
+}
+
+// GetLastMonitorCheck retrieves the latest check of a monitor. It returns nil
+// without an error when the monitor hasn't been checked yet.
+func (c *Client) GetLastMonitorCheck(ctx context.Context, id int) (*MonitorCheck, error) {
+	respBody, err := c.doRequest(ctx, "GET", fmt.Sprintf("/uptime/monitors/%d/checks/latest", id), nil)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get last monitor check: %w", err)
+	}
+
+	var check *MonitorCheck
+	if err := json.Unmarshal(respBody, &check); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	return resp.Data, nil
+	return check, nil
+}
+
+// GetMonitorWithRelated retrieves a monitor along with the requested related
+// resources (MonitorIncludeStats, MonitorIncludeIncidents,
+// MonitorIncludeLastCheck) in a single call.
+// Related resources the API doesn't embed are fetched with separate calls.
+func (c *Client) GetMonitorWithRelated(ctx context.Context, id int, include ...string) (*MonitorWithRelated, error) {
+	path := fmt.Sprintf("/uptime/monitors/%d", id)
+	if len(include) > 0 {
+		path += "?include=" + url.QueryEscape(strings.Join(include, ","))
+	}
+
+	var result MonitorWithRelated
+	embedded := map[string]json.RawMessage{}
+
+	respBody, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		if IsNotFound(err) || len(include) == 0 {
+			return nil, fmt.Errorf("failed to get monitor: %w", err)
+		}
+
+		// The include parameter may be rejected, fall back to a plain read
+		monitor, err := c.GetMonitor(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		result.Monitor = *monitor
+	} else {
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		if err := json.Unmarshal(respBody, &embedded); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	for _, related := range include {
+		if _, ok := embedded[related]; ok {
+			continue
+		}
+
+		switch related {
+		case MonitorIncludeStats:
+			stats, err := c.GetMonitorStats(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			result.Stats = stats
+		case MonitorIncludeIncidents:
+			incidents, err := c.ListMonitorIncidents(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			result.Incidents = incidents
+		case MonitorIncludeLastCheck:
+			check, err := c.GetLastMonitorCheck(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			result.LastCheck = check
+		}
+	}
+
+	return &result, nil
 }
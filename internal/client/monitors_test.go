@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetMonitorWithRelated(t *testing.T) {
+	tests := []struct {
+		name          string
+		monitorStatus int
+		monitorBody   string
+		wantPaths     []string
+	}{
+		{
+			name:          "related resources embedded",
+			monitorStatus: http.StatusOK,
+			monitorBody:   `{"id": 1, "name": "api", "stats": {"uptime_percentage": 99.9}, "incidents": [{"id": 7, "title": "Down"}]}`,
+			wantPaths:     []string{"/uptime/monitors/1"},
+		},
+		{
+			name:          "missing related resources fetched separately",
+			monitorStatus: http.StatusOK,
+			monitorBody:   `{"id": 1, "name": "api"}`,
+			wantPaths:     []string{"/uptime/monitors/1", "/uptime/monitors/1/stats", "/uptime/monitors/1/incidents"},
+		},
+		{
+			name:          "include rejected falls back to plain read",
+			monitorStatus: http.StatusBadRequest,
+			monitorBody:   `{"message": "Unknown include"}`,
+			wantPaths:     []string{"/uptime/monitors/1", "/uptime/monitors/1", "/uptime/monitors/1/stats", "/uptime/monitors/1/incidents"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var paths []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				paths = append(paths, r.URL.Path)
+
+				switch r.URL.Path {
+				case "/uptime/monitors/1":
+					if r.URL.Query().Get("include") != "" {
+						w.WriteHeader(tt.monitorStatus)
+						_, _ = w.Write([]byte(tt.monitorBody))
+						return
+					}
+					_, _ = w.Write([]byte(`{"id": 1, "name": "api"}`))
+				case "/uptime/monitors/1/stats":
+					_, _ = w.Write([]byte(`{"uptime_percentage": 99.9}`))
+				case "/uptime/monitors/1/incidents":
+					_, _ = w.Write([]byte(`{"data": [{"id": 7, "title": "Down"}]}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			c, err := NewClient("test-token", server.URL)
+			if err != nil {
+				t.Fatalf("NewClient() unexpected error: %v", err)
+			}
+
+			monitor, err := c.GetMonitorWithRelated(context.Background(), 1, MonitorIncludeStats, MonitorIncludeIncidents)
+			if err != nil {
+				t.Fatalf("GetMonitorWithRelated() unexpected error: %v", err)
+			}
+
+			if monitor.Name != "api" {
+				t.Errorf("Name = %v, want api", monitor.Name)
+			}
+			if monitor.Stats == nil || monitor.Stats.UptimePercentage == nil || *monitor.Stats.UptimePercentage != 99.9 {
+				t.Errorf("Stats = %+v, want uptime 99.9", monitor.Stats)
+			}
+			if len(monitor.Incidents) != 1 || monitor.Incidents[0].Title != "Down" {
+				t.Errorf("Incidents = %+v, want one incident", monitor.Incidents)
+			}
+
+			if len(paths) != len(tt.wantPaths) {
+				t.Fatalf("requested paths = %v, want %v", paths, tt.wantPaths)
+			}
+			for i := range paths {
+				if paths[i] != tt.wantPaths[i] {
+					t.Errorf("requested paths = %v, want %v", paths, tt.wantPaths)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestGetLastMonitorCheck(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		wantCheck  bool
+		wantResult CheckAssertionResult
+	}{
+		{
+			name:       "latest check",
+			status:     http.StatusOK,
+			body:       `{"checked_at": "2024-01-01T00:00:00Z", "assertion_results": [{"type": "status_code", "passed": false, "actual": "503"}]}`,
+			wantCheck:  true,
+			wantResult: CheckAssertionResult{Type: "status_code", Passed: false, Actual: stringPtr("503")},
+		},
+		{
+			name:   "no check yet",
+			status: http.StatusNotFound,
+			body:   `{"message": "No check found"}`,
+		},
+		{
+			name:   "null check",
+			status: http.StatusOK,
+			body:   `null`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/uptime/monitors/1/checks/latest" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			c, err := NewClient("test-token", server.URL)
+			if err != nil {
+				t.Fatalf("NewClient() unexpected error: %v", err)
+			}
+
+			check, err := c.GetLastMonitorCheck(context.Background(), 1)
+			if err != nil {
+				t.Fatalf("GetLastMonitorCheck() unexpected error: %v", err)
+			}
+
+			if !tt.wantCheck {
+				if check != nil {
+					t.Errorf("GetLastMonitorCheck() = %+v, want nil", check)
+				}
+				return
+			}
+			if check == nil || len(check.AssertionResults) != 1 {
+				t.Fatalf("GetLastMonitorCheck() = %+v, want one assertion result", check)
+			}
+			got := check.AssertionResults[0]
+			if got.Type != tt.wantResult.Type || got.Passed != tt.wantResult.Passed || *got.Actual != *tt.wantResult.Actual {
+				t.Errorf("AssertionResults[0] = %+v, want %+v", got, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestFindMonitorByName(t *testing.T) {
+	// Two pages of monitors, linked by cursor
+	pages := map[string]string{
+		"":   `{"data": [{"id": 1, "name": "API"}, {"id": 2, "name": "Website"}], "meta": {"next_cursor": "p2"}}`,
+		"p2": `{"data": [{"id": 3, "name": "Worker"}], "meta": {"next_cursor": null}}`,
+	}
+
+	tests := []struct {
+		name         string
+		monitorName  string
+		limit        int
+		wantID       int
+		wantRequests int
+	}{
+		{name: "found on the first page", monitorName: "Website", wantID: 2, wantRequests: 1},
+		{name: "found on the last page", monitorName: "Worker", wantID: 3, wantRequests: 2},
+		{name: "not found", monitorName: "Database", wantRequests: 2},
+		{name: "beyond the limit", monitorName: "Worker", limit: 2, wantRequests: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.URL.Path != "/uptime/monitors" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				_, _ = w.Write([]byte(pages[r.URL.Query().Get("cursor")]))
+			}))
+			defer server.Close()
+
+			c, err := NewClient("test-token", server.URL)
+			if err != nil {
+				t.Fatalf("NewClient() unexpected error: %v", err)
+			}
+
+			monitor, err := c.FindMonitorByName(context.Background(), tt.monitorName, tt.limit)
+			if err != nil {
+				t.Fatalf("FindMonitorByName() unexpected error: %v", err)
+			}
+
+			switch {
+			case tt.wantID == 0 && monitor != nil:
+				t.Errorf("FindMonitorByName() = monitor %d, want none", *monitor.ID)
+			case tt.wantID != 0 && (monitor == nil || *monitor.ID != tt.wantID):
+				t.Errorf("FindMonitorByName() = %v, want monitor %d", monitor, tt.wantID)
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
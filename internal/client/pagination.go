@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ListResponse represents a page of a list response
+type ListResponse[T any] struct {
+	Data []T      `json:"data"`
+	Meta ListMeta `json:"meta"`
+}
+
+// ListMeta represents the cursor pagination metadata of a list response
+type ListMeta struct {
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// listAll lists the items at path, following the pagination cursor until
+// limit items have been read or the last page is reached. A limit of 0 or
+// less lists all items.
+func listAll[T any](ctx context.Context, c *Client, path string, limit int) ([]T, error) {
+	var items []T
+	err := walkPages(ctx, c, path, func(page []T) bool {
+		items = append(items, page...)
+		return limit <= 0 || len(items) < limit
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	return items, nil
+}
+
+// walkPages calls visit with the items of each page at path, following the
+// pagination cursor until visit returns false or the last page is reached
+func walkPages[T any](ctx context.Context, c *Client, path string, visit func(page []T) bool) error {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	next := path
+	for {
+		respBody, err := c.doRequest(ctx, "GET", next, nil)
+		if err != nil {
+			return err
+		}
+
+		var resp ListResponse[T]
+		if err := json.Unmarshal(respBody, &resp); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		if !visit(resp.Data) || resp.Meta.NextCursor == nil || *resp.Meta.NextCursor == "" {
+			return nil
+		}
+		next = path + separator + "cursor=" + url.QueryEscape(*resp.Meta.NextCursor)
+	}
+}
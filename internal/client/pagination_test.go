@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListPagination(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		list func(c *Client) (int, error)
+	}{
+		{
+			name: "monitors",
+			path: "/uptime/monitors",
+			list: func(c *Client) (int, error) {
+				monitors, err := c.ListMonitors(context.Background())
+				return len(monitors), err
+			},
+		},
+		{
+			name: "status pages",
+			path: "/uptime/status-pages",
+			list: func(c *Client) (int, error) {
+				pages, err := c.ListStatusPages(context.Background())
+				return len(pages), err
+			},
+		},
+		{
+			name: "alert rules",
+			path: "/alert-rules",
+			list: func(c *Client) (int, error) {
+				rules, err := c.ListAlertRules(context.Background())
+				return len(rules), err
+			},
+		},
+		{
+			name: "monitor incidents",
+			path: "/uptime/monitors/1/incidents",
+			list: func(c *Client) (int, error) {
+				incidents, err := c.ListMonitorIncidents(context.Background(), 1)
+				return len(incidents), err
+			},
+		},
+		{
+			name: "integrations",
+			path: "/integrations",
+			list: func(c *Client) (int, error) {
+				integrations, err := c.ListIntegrations(context.Background())
+				return len(integrations), err
+			},
+		},
+		{
+			name: "escalation policies",
+			path: "/escalation-policies",
+			list: func(c *Client) (int, error) {
+				policies, err := c.ListEscalationPolicies(context.Background())
+				return len(policies), err
+			},
+		},
+	}
+
+	// Two pages of items, linked by cursor
+	pages := map[string]string{
+		"":   `{"data": [{"id": 1}, {"id": 2}], "meta": {"next_cursor": "p2"}}`,
+		"p2": `{"data": [{"id": 3}], "meta": {"next_cursor": null}}`,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.URL.Path != tt.path {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				body, ok := pages[r.URL.Query().Get("cursor")]
+				if !ok {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = w.Write([]byte(`{"message": "invalid cursor"}`))
+					return
+				}
+				_, _ = w.Write([]byte(body))
+			}))
+			defer server.Close()
+
+			c, err := NewClient("test-token", server.URL)
+			if err != nil {
+				t.Fatalf("NewClient() unexpected error: %v", err)
+			}
+
+			count, err := tt.list(c)
+			if err != nil {
+				t.Fatalf("list unexpected error: %v", err)
+			}
+			if count != 3 {
+				t.Errorf("list returned %d items, want 3", count)
+			}
+			if requests != 2 {
+				t.Errorf("requests = %d, want 2", requests)
+			}
+		})
+	}
+}
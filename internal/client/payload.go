@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// updatePayload returns the request body for an update operation. Each of the
+// nullable fields that is omitted from v is sent as an explicit null so the API
+// clears its previous value instead of keeping it. Nested fields are addressed
+// with dot-separated paths (e.g. "request.body").
+func updatePayload(v interface{}, nullable []string) (map[string]interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	var payload map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&payload); err != nil {
+		return nil, fmt.Errorf("failed to build update payload: %w", err)
+	}
+
+	for _, field := range nullable {
+		setNullIfAbsent(payload, strings.Split(field, "."))
+	}
+
+	return payload, nil
+}
+
+// setNullIfAbsent sets the field at path to null when it is missing. Paths
+// whose parent object is missing are left untouched.
+func setNullIfAbsent(payload map[string]interface{}, path []string) {
+	if len(path) == 1 {
+		if _, ok := payload[path[0]]; !ok {
+			payload[path[0]] = nil
+		}
+		return
+	}
+
+	child, ok := payload[path[0]].(map[string]interface{})
+	if !ok {
+		return
+	}
+	setNullIfAbsent(child, path[1:])
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMonitorPayloadNullHandling(t *testing.T) {
+	tests := []struct {
+		name     string
+		call     func(c *Client, monitor *Monitor) error
+		wantBody bool
+	}{
+		{
+			name: "create omits unset body",
+			call: func(c *Client, monitor *Monitor) error {
+				_, err := c.CreateMonitor(context.Background(), monitor)
+				return err
+			},
+			wantBody: false,
+		},
+		{
+			name: "update sends unset body as null",
+			call: func(c *Client, monitor *Monitor) error {
+				_, err := c.UpdateMonitor(context.Background(), 1, monitor)
+				return err
+			},
+			wantBody: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received map[string]json.RawMessage
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				raw, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatalf("failed to read request body: %v", err)
+				}
+				if err := json.Unmarshal(raw, &received); err != nil {
+					t.Fatalf("failed to decode request body: %v", err)
+				}
+				_, _ = w.Write([]byte(`{"id": 1}`))
+			}))
+			defer server.Close()
+
+			c, err := NewClient("test-token", server.URL)
+			if err != nil {
+				t.Fatalf("NewClient() unexpected error: %v", err)
+			}
+
+			monitor := &Monitor{
+				Name:     "test",
+				Protocol: "http",
+				Request: MonitorRequest{
+					Method: stringPtr("GET"),
+					URL:    stringPtr("https://example.com"),
+				},
+				Interval: 60,
+				Regions:  []string{"na-usa-iad"},
+			}
+
+			if err := tt.call(c, monitor); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var request map[string]json.RawMessage
+			if err := json.Unmarshal(received["request"], &request); err != nil {
+				t.Fatalf("failed to decode request field: %v", err)
+			}
+
+			body, ok := request["body"]
+			if ok != tt.wantBody {
+				t.Fatalf("request.body present = %v, want %v", ok, tt.wantBody)
+			}
+			if ok && string(body) != "null" {
+				t.Errorf("request.body = %s, want null", body)
+			}
+			// Unset success assertions are cleared like the request body
+			assertions, ok := received["success_assertions"]
+			if ok != tt.wantBody {
+				t.Fatalf("success_assertions present = %v, want %v", ok, tt.wantBody)
+			}
+			if ok && string(assertions) != "null" {
+				t.Errorf("success_assertions = %s, want null", assertions)
+			}
+			if string(request["method"]) != `"GET"` {
+				t.Errorf("request.method = %s, want \"GET\"", request["method"])
+			}
+			if string(received["interval"]) != "60" {
+				t.Errorf("interval = %s, want 60", received["interval"])
+			}
+		})
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpdateProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/projects/1" {
+			t.Errorf("request = %s %s, want POST /projects/1", r.Method, r.URL.Path)
+		}
+
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		if description, ok := payload["description"]; !ok || description != nil {
+			t.Errorf("payload description = %v, want explicit null", payload["description"])
+		}
+		if _, ok := payload["slug"]; ok {
+			t.Errorf("payload contains server-managed field %q", "slug")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1, "name": "Platform", "slug": "platform"}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("token", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	updated, err := c.UpdateProject(context.Background(), 1, &Project{Name: "Platform"})
+	if err != nil {
+		t.Fatalf("UpdateProject() unexpected error: %v", err)
+	}
+	if updated.Slug == nil || *updated.Slug != "platform" {
+		t.Errorf("UpdateProject() = %+v, want the updated project", updated)
+	}
+}
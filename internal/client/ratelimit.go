@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// rateLimitMinRemaining is the number of remaining requests below which
+	// requests wait for the rate limit window to reset.
+	rateLimitMinRemaining = 2
+
+	// rateLimitMaxWait caps the wait for a rate limit window to reset.
+	rateLimitMaxWait = time.Minute
+
+	// rateLimitEpochThreshold separates X-RateLimit-Reset values given as a
+	// Unix timestamp from values given as seconds until the reset.
+	rateLimitEpochThreshold = 1_000_000_000
+)
+
+// rateLimiter tracks the rate limit reported by the X-RateLimit-Remaining and
+// X-RateLimit-Reset response headers, so that requests are throttled before
+// the limit is reached rather than rejected with 429 Too Many Requests
+type rateLimiter struct {
+	mu        sync.Mutex
+	remaining int
+	reset     time.Time
+}
+
+// update records the rate limit reported by a response. Responses without
+// both headers leave the recorded rate limit untouched.
+func (l *rateLimiter) update(header http.Header, now time.Time) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil || reset < 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.remaining = remaining
+	if reset >= rateLimitEpochThreshold {
+		l.reset = time.Unix(reset, 0)
+	} else {
+		l.reset = now.Add(time.Duration(reset) * time.Second)
+	}
+}
+
+// delay returns how long to wait before sending a request, which is until
+// the rate limit window resets when fewer than rateLimitMinRemaining requests
+// remain in it
+func (l *rateLimiter) delay(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.reset.IsZero() || l.remaining >= rateLimitMinRemaining || !now.Before(l.reset) {
+		return 0
+	}
+
+	return min(l.reset.Sub(now), rateLimitMaxWait)
+}
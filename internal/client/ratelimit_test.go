@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterDelay(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+
+	tests := []struct {
+		name      string
+		remaining string
+		reset     string
+		want      time.Duration
+	}{
+		{
+			name:      "no rate limit headers",
+			remaining: "",
+			reset:     "",
+			want:      0,
+		},
+		{
+			name:      "requests remaining",
+			remaining: "10",
+			reset:     "30",
+			want:      0,
+		},
+		{
+			name:      "reset in seconds",
+			remaining: "1",
+			reset:     "30",
+			want:      30 * time.Second,
+		},
+		{
+			name:      "reset as timestamp",
+			remaining: "0",
+			reset:     strconv.FormatInt(now.Unix()+10, 10),
+			want:      10 * time.Second,
+		},
+		{
+			name:      "reset in the past",
+			remaining: "0",
+			reset:     strconv.FormatInt(now.Unix()-10, 10),
+			want:      0,
+		},
+		{
+			name:      "reset capped",
+			remaining: "0",
+			reset:     "3600",
+			want:      rateLimitMaxWait,
+		},
+		{
+			name:      "invalid reset",
+			remaining: "0",
+			reset:     "soon",
+			want:      0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.remaining != "" {
+				header.Set("X-RateLimit-Remaining", tt.remaining)
+			}
+			if tt.reset != "" {
+				header.Set("X-RateLimit-Reset", tt.reset)
+			}
+
+			var l rateLimiter
+			l.update(header, now)
+			if got := l.delay(now); got != tt.want {
+				t.Errorf("delay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoRequestRateLimitThrottle(t *testing.T) {
+	var requests []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, time.Now())
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "1")
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token", server.URL)
+	if err != nil {
+		t.Fatalf("NewClient() unexpected error: %v", err)
+	}
+
+	for range 2 {
+		if _, err := c.doRequest(context.Background(), http.MethodGet, "/uptime/monitors", nil); err != nil {
+			t.Fatalf("doRequest() unexpected error: %v", err)
+		}
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("doRequest() made %d requests, want 2", len(requests))
+	}
+	if waited := requests[1].Sub(requests[0]); waited < 900*time.Millisecond {
+		t.Errorf("second request sent after %v, want it to wait for the rate limit reset", waited)
+	}
+
+	// The wait for the reset is bounded by the context
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = c.doRequest(ctx, http.MethodGet, "/uptime/monitors", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("doRequest() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if len(requests) != 2 {
+		t.Errorf("doRequest() sent a request before the rate limit reset")
+	}
+}
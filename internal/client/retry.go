@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// DefaultRetryOnStatus are the response status codes retried by default
+var DefaultRetryOnStatus = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// retryMaxDelay caps the wait between retries of transient errors.
+const retryMaxDelay = 30 * time.Second
+
+// isTransientResponse reports whether a failed request may succeed when
+// retried, based on the status codes to retry on. Rate limited requests were
+// not processed and are retried for any method, other statuses only for
+// idempotent methods so that writes aren't applied twice.
+func isTransientResponse(method string, statusCode int, retryOn []int) bool {
+	if !slices.Contains(retryOn, statusCode) {
+		return false
+	}
+	if statusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// transientRetryDelay returns how long to wait before retrying a transient
+// error. The Retry-After header takes precedence over exponential backoff
+// with jitter starting at baseDelay.
+func transientRetryDelay(retryAfter string, baseDelay time.Duration, attempt int) time.Duration {
+	if d, ok := parseRetryAfter(retryAfter); ok {
+		return min(d, retryMaxDelay)
+	}
+
+	delay := min(baseDelay<<attempt, retryMaxDelay)
+
+	// Spread retries of concurrent requests over the second half of the delay
+	half := delay / 2
+	return half + rand.N(half+1)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDoRequestTransientRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		statusCode   int
+		maxRetries   int
+		retryOn      []int
+		wantRequests int
+		wantError    bool
+	}{
+		{
+			name:         "rate limited request is retried",
+			method:       http.MethodPost,
+			statusCode:   http.StatusTooManyRequests,
+			maxRetries:   3,
+			wantRequests: 3,
+			wantError:    false,
+		},
+		{
+			name:         "bad gateway is retried for idempotent methods",
+			method:       http.MethodGet,
+			statusCode:   http.StatusBadGateway,
+			maxRetries:   3,
+			wantRequests: 3,
+			wantError:    false,
+		},
+		{
+			name:         "bad gateway is not retried for creates",
+			method:       http.MethodPost,
+			statusCode:   http.StatusBadGateway,
+			maxRetries:   3,
+			wantRequests: 1,
+			wantError:    true,
+		},
+		{
+			name:         "internal server error is not retried by default",
+			method:       http.MethodGet,
+			statusCode:   http.StatusInternalServerError,
+			maxRetries:   3,
+			wantRequests: 1,
+			wantError:    true,
+		},
+		{
+			name:         "internal server error is retried when listed",
+			method:       http.MethodGet,
+			statusCode:   http.StatusInternalServerError,
+			maxRetries:   3,
+			retryOn:      []int{http.StatusInternalServerError},
+			wantRequests: 3,
+			wantError:    false,
+		},
+		{
+			name:         "rate limited request is not retried when unlisted",
+			method:       http.MethodGet,
+			statusCode:   http.StatusTooManyRequests,
+			maxRetries:   3,
+			retryOn:      []int{http.StatusBadGateway},
+			wantRequests: 1,
+			wantError:    true,
+		},
+		{
+			name:         "validation error is not retried",
+			method:       http.MethodPut,
+			statusCode:   http.StatusUnprocessableEntity,
+			maxRetries:   3,
+			wantRequests: 1,
+			wantError:    true,
+		},
+		{
+			name:         "retries exhausted",
+			method:       http.MethodGet,
+			statusCode:   http.StatusTooManyRequests,
+			maxRetries:   1,
+			wantRequests: 2,
+			wantError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests < 3 {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(tt.statusCode)
+					_, _ = w.Write([]byte(`{"message": "failed"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"id": 1}`))
+			}))
+			defer server.Close()
+
+			c, err := NewClient("test-token", server.URL, WithMaxRetries(tt.maxRetries), WithRetryOnStatus(tt.retryOn))
+			if err != nil {
+				t.Fatalf("NewClient() unexpected error: %v", err)
+			}
+
+			_, err = c.doRequest(context.Background(), tt.method, "/uptime/monitors", nil)
+			if tt.wantError && err == nil {
+				t.Error("doRequest() expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("doRequest() unexpected error: %v", err)
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("doRequest() made %d requests, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestTransientRetryDelay(t *testing.T) {
+	if got := transientRetryDelay("5", DefaultRetryDelay, 0); got != 5*time.Second {
+		t.Errorf("transientRetryDelay() with Retry-After = %v, want 5s", got)
+	}
+	if got := transientRetryDelay("3600", DefaultRetryDelay, 0); got != retryMaxDelay {
+		t.Errorf("transientRetryDelay() = %v, want capped at %v", got, retryMaxDelay)
+	}
+
+	for attempt := range 8 {
+		delay := min(DefaultRetryDelay<<attempt, retryMaxDelay)
+		got := transientRetryDelay("", DefaultRetryDelay, attempt)
+		if got < delay/2 || got > delay {
+			t.Errorf("transientRetryDelay(attempt %d) = %v, want between %v and %v", attempt, got, delay/2, delay)
+		}
+	}
+}
+
+func TestDoRequestRetryDelay(t *testing.T) {
+	var requests []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, time.Now())
+		if len(requests) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message": "rate limited"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	retryDelay := 200 * time.Millisecond
+	c, err := NewClient("test-token", server.URL, WithRetryDelay(retryDelay))
+	if err != nil {
+		t.Fatalf("NewClient() unexpected error: %v", err)
+	}
+
+	if _, err := c.doRequest(context.Background(), http.MethodGet, "/uptime/monitors", nil); err != nil {
+		t.Fatalf("doRequest() unexpected error: %v", err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("doRequest() made %d requests, want 2", len(requests))
+	}
+	if waited := requests[1].Sub(requests[0]); waited < retryDelay/2 {
+		t.Errorf("retry sent after %v, want at least %v", waited, retryDelay/2)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// StatusPageIncident represents an announcement posted on a status page,
+// optionally linked to an uptime incident
+type StatusPageIncident struct {
+	ID          *int    `json:"id,omitempty"`
+	IncidentID  *int    `json:"incident_id,omitempty"`
+	Title       string  `json:"title"`
+	Body        string  `json:"body"`
+	Impact      string  `json:"impact"`
+	PublishedAt *string `json:"published_at,omitempty"`
+	CreatedAt   *string `json:"created_at,omitempty"`
+	UpdatedAt   *string `json:"updated_at,omitempty"`
+}
+
+// statusPageIncidentNullableFields lists the optional status page incident
+// fields that are sent as explicit nulls on update so removing them clears them.
+var statusPageIncidentNullableFields = []string{"incident_id"}
+
+// CreateStatusPageIncident posts a new incident on a status page
+func (c *Client) CreateStatusPageIncident(ctx context.Context, pageID int, incident *StatusPageIncident) (*StatusPageIncident, error) {
+	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/uptime/status-pages/%d/incidents", pageID), incident)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create status page incident: %w", err)
+	}
+
+	var created StatusPageIncident
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetStatusPageIncident retrieves an incident of a status page by ID
+func (c *Client) GetStatusPageIncident(ctx context.Context, pageID, id int) (*StatusPageIncident, error) {
+	respBody, err := c.doRequest(ctx, "GET", fmt.Sprintf("/uptime/status-pages/%d/incidents/%d", pageID, id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status page incident: %w", err)
+	}
+
+	var incident StatusPageIncident
+	if err := json.Unmarshal(respBody, &incident); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &incident, nil
+}
+
+// UpdateStatusPageIncident updates an existing incident of a status page
+func (c *Client) UpdateStatusPageIncident(ctx context.Context, pageID, id int, incident *StatusPageIncident) (*StatusPageIncident, error) {
+	payload, err := updatePayload(incident, statusPageIncidentNullableFields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update status page incident: %w", err)
+	}
+
+	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/uptime/status-pages/%d/incidents/%d", pageID, id), payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update status page incident: %w", err)
+	}
+
+	var updated StatusPageIncident
+	if err := json.Unmarshal(respBody, &updated); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteStatusPageIncident deletes an incident of a status page
+func (c *Client) DeleteStatusPageIncident(ctx context.Context, pageID, id int) error {
+	_, err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/uptime/status-pages/%d/incidents/%d", pageID, id), nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete status page incident: %w", err)
+	}
+
+	return nil
+}
@@ -4,9 +4,13 @@
 package client
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strconv"
+	"time"
 )
 
 // StatusPage represents a Phare status page
@@ -38,17 +42,63 @@ type StatusPageColors struct {
 	Empty               string `json:"empty"`
 }
 
+// DefaultStatusPageColors are the status page colors used when neither the
+// status page nor the provider configures them
+var DefaultStatusPageColors = StatusPageColors{
+	Operational:         "#16a34a",
+	DegradedPerformance: "#fbbf24",
+	PartialOutage:       "#f59e0b",
+	MajorOutage:         "#ef4444",
+	Maintenance:         "#6366f1",
+	Empty:               "#d3d3d3",
+}
+
+// withFallback returns the colors with the empty ones taken from fallback
+func (colors StatusPageColors) withFallback(fallback StatusPageColors) StatusPageColors {
+	return StatusPageColors{
+		Operational:         cmp.Or(colors.Operational, fallback.Operational),
+		DegradedPerformance: cmp.Or(colors.DegradedPerformance, fallback.DegradedPerformance),
+		PartialOutage:       cmp.Or(colors.PartialOutage, fallback.PartialOutage),
+		MajorOutage:         cmp.Or(colors.MajorOutage, fallback.MajorOutage),
+		Maintenance:         cmp.Or(colors.Maintenance, fallback.Maintenance),
+		Empty:               cmp.Or(colors.Empty, fallback.Empty),
+	}
+}
+
 // StatusComponent represents a component on a status page
 type StatusComponent struct {
 	ComponentableType string `json:"componentable_type"`
 	ComponentableID   int    `json:"componentable_id"`
 }
 
-// StatusPageListResponse represents the response from listing status pages
-type StatusPageListResponse struct {
-	Data []StatusPage `json:"data"`
+// UnmarshalJSON decodes a status page component, accepting componentable_id
+// as either a number or a numeric string
+func (sc *StatusComponent) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ComponentableType string      `json:"componentable_type"`
+		ComponentableID   json.Number `json:"componentable_id"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	id, err := strconv.Atoi(raw.ComponentableID.String())
+	if err != nil {
+		return fmt.Errorf("invalid componentable_id %q: %w", raw.ComponentableID, err)
+	}
+
+	sc.ComponentableType = raw.ComponentableType
+	sc.ComponentableID = id
+	return nil
 }
 
+// statusPageNullableFields lists the optional status page fields that are
+// sent as explicit nulls on update so removing them clears them.
+var statusPageNullableFields = []string{"domain", "logo", "favicon"}
+
+// StatusPageListResponse represents the response from listing status pages
+type StatusPageListResponse = ListResponse[StatusPage]
+
 // StatusPageResponse represents the response from creating/getting a status page
 type StatusPageResponse struct {
 	Data StatusPage `json:"data"`
@@ -84,9 +134,24 @@ func (c *Client) GetStatusPage(ctx context.Context, id int) (*StatusPage, error)
 	return &page, nil
 }
 
-// UpdateStatusPage updates an existing status page
+// UpdateStatusPage updates an existing status page. When page.UpdatedAt is set
+// the update is only applied if the page hasn't been modified since then,
+// otherwise an error satisfying IsConflict is returned.
 func (c *Client) UpdateStatusPage(ctx context.Context, id int, page *StatusPage) (*StatusPage, error) {
-	respBody, err := c.doRequest(ctx, "POST", fmt.Sprintf("/uptime/status-pages/%d", id), page)
+	payload, err := updatePayload(page, statusPageNullableFields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update status page: %w", err)
+	}
+
+	var headers http.Header
+	if page.UpdatedAt != nil {
+		delete(payload, "updated_at")
+		if updatedAt, err := time.Parse(time.RFC3339, *page.UpdatedAt); err == nil {
+			headers = http.Header{"If-Unmodified-Since": {updatedAt.UTC().Format(http.TimeFormat)}}
+		}
+	}
+
+	respBody, err := c.doRequestWithHeaders(ctx, "POST", fmt.Sprintf("/uptime/status-pages/%d", id), payload, headers)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update status page: %w", err)
 	}
@@ -109,17 +174,33 @@ func (c *Client) DeleteStatusPage(ctx context.Context, id int) error {
 	return nil
 }
 
-// ListStatusPages lists all status pages
+// ListStatusPages lists all status pages, following the pagination cursor
 func (c *Client) ListStatusPages(ctx context.Context) ([]StatusPage, error) {
-	respBody, err := c.doRequest(ctx, "GET", "/uptime/status-pages", nil)
+	pages, err := listAll[StatusPage](ctx, c, "/uptime/status-pages", 0)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list status pages: %w", err)
 	}
 
-	var resp StatusPageListResponse
-	if err := json.Unmarshal(respBody, &resp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	return pages, nil
+}
+
+// ListStatusPagesByMonitor lists the status pages displaying the given
+// monitor as one of their components
+func (c *Client) ListStatusPagesByMonitor(ctx context.Context, monitorID int) ([]StatusPage, error) {
+	pages, err := c.ListStatusPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []StatusPage
+	for _, page := range pages {
+		for _, component := range page.Components {
+			if component.ComponentableType == "uptime/monitor" && component.ComponentableID == monitorID {
+				matching = append(matching, page)
+				break
+			}
+		}
 	}
 
-	return resp.Data, nil
+	return matching, nil
 }
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStatusComponentUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantID    int
+		wantError bool
+	}{
+		{
+			name:   "numeric componentable_id",
+			input:  `{"componentable_type": "uptime/monitor", "componentable_id": 42}`,
+			wantID: 42,
+		},
+		{
+			name:   "string componentable_id",
+			input:  `{"componentable_type": "uptime/monitor", "componentable_id": "42"}`,
+			wantID: 42,
+		},
+		{
+			name:      "non-numeric componentable_id",
+			input:     `{"componentable_type": "uptime/monitor", "componentable_id": "abc"}`,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var component StatusComponent
+			err := json.Unmarshal([]byte(tt.input), &component)
+
+			if tt.wantError {
+				if err == nil {
+					t.Errorf("Unmarshal() expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unmarshal() unexpected error: %v", err)
+			}
+			if component.ComponentableType != "uptime/monitor" {
+				t.Errorf("ComponentableType = %v, want uptime/monitor", component.ComponentableType)
+			}
+			if component.ComponentableID != tt.wantID {
+				t.Errorf("ComponentableID = %v, want %v", component.ComponentableID, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestListStatusPagesByMonitor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [
+			{"id": 1, "name": "Public", "components": [{"componentable_type": "uptime/monitor", "componentable_id": 7}]},
+			{"id": 2, "name": "Internal", "components": [{"componentable_type": "uptime/monitor", "componentable_id": 8}]},
+			{"id": 3, "name": "Partners", "components": [
+				{"componentable_type": "uptime/monitor", "componentable_id": 8},
+				{"componentable_type": "uptime/monitor", "componentable_id": "7"}
+			]}
+		]}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("token", server.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	pages, err := c.ListStatusPagesByMonitor(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(pages) != 2 || *pages[0].ID != 1 || *pages[1].ID != 3 {
+		t.Errorf("expected status pages 1 and 3, got %+v", pages)
+	}
+}
+
+func TestUpdateStatusPageIfUnmodifiedSince(t *testing.T) {
+	tests := []struct {
+		name         string
+		updatedAt    *string
+		status       int
+		wantHeader   string
+		wantConflict bool
+	}{
+		{
+			name:       "unmodified page is updated",
+			updatedAt:  stringPtr("2024-05-01T10:20:30.000000Z"),
+			status:     http.StatusOK,
+			wantHeader: "Wed, 01 May 2024 10:20:30 GMT",
+		},
+		{
+			name:         "modified page is rejected",
+			updatedAt:    stringPtr("2024-05-01T10:20:30Z"),
+			status:       http.StatusPreconditionFailed,
+			wantHeader:   "Wed, 01 May 2024 10:20:30 GMT",
+			wantConflict: true,
+		},
+		{
+			name:   "no precondition without updated_at",
+			status: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotHeader string
+			var gotBody map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotHeader = r.Header.Get("If-Unmodified-Since")
+				_ = json.NewDecoder(r.Body).Decode(&gotBody)
+
+				w.WriteHeader(tt.status)
+				if tt.status == http.StatusOK {
+					_, _ = w.Write([]byte(`{"id": 1, "name": "Public"}`))
+				} else {
+					_, _ = w.Write([]byte(`{"message": "The status page was modified."}`))
+				}
+			}))
+			defer server.Close()
+
+			c, err := NewClient("token", server.URL)
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			_, err = c.UpdateStatusPage(context.Background(), 1, &StatusPage{Name: "Public", UpdatedAt: tt.updatedAt})
+
+			if gotHeader != tt.wantHeader {
+				t.Errorf("If-Unmodified-Since = %q, want %q", gotHeader, tt.wantHeader)
+			}
+			if _, ok := gotBody["updated_at"]; ok {
+				t.Error("updated_at should not be sent in the request body")
+			}
+			if IsConflict(err) != tt.wantConflict {
+				t.Errorf("IsConflict(%v) = %v, want %v", err, IsConflict(err), tt.wantConflict)
+			}
+		})
+	}
+}
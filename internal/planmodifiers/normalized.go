@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package planmodifiers holds the plan modifiers shared by the resources of
+// the provider.
+package planmodifiers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Normalizer describes a normalization the Phare API applies to an input
+type Normalizer struct {
+	normalize  func(string) string
+	difference string
+}
+
+var (
+	// TrimSpace matches the API trimming whitespace around URLs
+	TrimSpace = Normalizer{normalize: strings.TrimSpace, difference: "surrounding whitespace"}
+
+	// Lowercase matches the API lowercasing region codes and colors
+	Lowercase = Normalizer{normalize: strings.ToLower, difference: "letter case"}
+)
+
+// Equivalent reports whether a and b are stored as the same value by the API
+func (n Normalizer) Equivalent(a, b string) bool {
+	return n.normalize(a) == n.normalize(b)
+}
+
+// PlannedString returns planned when the API stored an equivalent value, and
+// the stored value otherwise. Terraform requires the state after an apply to
+// match the plan, which holds the configured value rather than the one the
+// API normalized.
+func PlannedString(planned types.String, stored string, normalizer Normalizer) types.String {
+	if !planned.IsNull() && !planned.IsUnknown() && normalizer.Equivalent(planned.ValueString(), stored) {
+		return planned
+	}
+	return types.StringValue(stored)
+}
+
+var _ planmodifier.String = normalizedStringPlanModifier{}
+
+// NormalizedString returns a plan modifier keeping the prior state when the
+// configured value only differs from it by the normalization of the API, so
+// that the value the API stored doesn't show up as a diff.
+func NormalizedString(normalizer Normalizer) planmodifier.String {
+	return normalizedStringPlanModifier{normalizer: normalizer}
+}
+
+type normalizedStringPlanModifier struct {
+	normalizer Normalizer
+}
+
+func (m normalizedStringPlanModifier) Description(ctx context.Context) string {
+	return fmt.Sprintf("Keeps the prior state when the configured value only differs from it by %s.", m.normalizer.difference)
+}
+
+func (m normalizedStringPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m normalizedStringPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	if m.normalizer.Equivalent(req.ConfigValue.ValueString(), req.StateValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+var _ planmodifier.Set = normalizedStringSetPlanModifier{}
+
+// NormalizedStringSet returns a plan modifier keeping the prior state when the
+// configured set of strings only differs from it by the normalization of the
+// API.
+func NormalizedStringSet(normalizer Normalizer) planmodifier.Set {
+	return normalizedStringSetPlanModifier{normalizer: normalizer}
+}
+
+type normalizedStringSetPlanModifier struct {
+	normalizer Normalizer
+}
+
+func (m normalizedStringSetPlanModifier) Description(ctx context.Context) string {
+	return fmt.Sprintf("Keeps the prior state when the configured elements only differ from it by %s.", m.normalizer.difference)
+}
+
+func (m normalizedStringSetPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m normalizedStringSetPlanModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	configured, ok := m.normalizedElements(req.ConfigValue)
+	if !ok {
+		return
+	}
+	prior, ok := m.normalizedElements(req.StateValue)
+	if !ok {
+		return
+	}
+
+	if slices.Equal(configured, prior) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// normalizedElements returns the sorted, deduplicated normalized elements of
+// set, or false when an element isn't a known string
+func (m normalizedStringSetPlanModifier) normalizedElements(set types.Set) ([]string, bool) {
+	elements := make([]string, 0, len(set.Elements()))
+	for _, element := range set.Elements() {
+		s, ok := element.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			return nil, false
+		}
+		elements = append(elements, m.normalizer.normalize(s.ValueString()))
+	}
+
+	slices.Sort(elements)
+	return slices.Compact(elements), true
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifiers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizedString(t *testing.T) {
+	tests := []struct {
+		name       string
+		normalizer Normalizer
+		config     types.String
+		state      types.String
+		want       types.String
+	}{
+		{
+			name:       "trimmed url keeps the prior state",
+			normalizer: TrimSpace,
+			config:     types.StringValue(" https://example.com\n"),
+			state:      types.StringValue("https://example.com"),
+			want:       types.StringValue("https://example.com"),
+		},
+		{
+			name:       "lowercased color keeps the prior state",
+			normalizer: Lowercase,
+			config:     types.StringValue("#EF4444"),
+			state:      types.StringValue("#ef4444"),
+			want:       types.StringValue("#ef4444"),
+		},
+		{
+			name:       "changed value is planned",
+			normalizer: Lowercase,
+			config:     types.StringValue("#16A34A"),
+			state:      types.StringValue("#ef4444"),
+			want:       types.StringValue("#16A34A"),
+		},
+		{
+			name:       "create plans the configured value",
+			normalizer: TrimSpace,
+			config:     types.StringValue(" https://example.com"),
+			state:      types.StringNull(),
+			want:       types.StringValue(" https://example.com"),
+		},
+		{
+			name:       "unknown config is planned",
+			normalizer: TrimSpace,
+			config:     types.StringUnknown(),
+			state:      types.StringValue("https://example.com"),
+			want:       types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				ConfigValue: tt.config,
+				StateValue:  tt.state,
+				PlanValue:   tt.config,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: tt.config}
+
+			NormalizedString(tt.normalizer).PlanModifyString(context.Background(), req, resp)
+
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Errorf("plan = %v, want %v", resp.PlanValue, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizedStringSet(t *testing.T) {
+	set := func(elements ...string) types.Set {
+		values := make([]attr.Value, len(elements))
+		for i, e := range elements {
+			values[i] = types.StringValue(e)
+		}
+		return types.SetValueMust(types.StringType, values)
+	}
+
+	tests := []struct {
+		name   string
+		config types.Set
+		state  types.Set
+		want   types.Set
+	}{
+		{
+			name:   "uppercase regions keep the prior state",
+			config: set("EU-DEU-FRA", "na-usa-iad"),
+			state:  set("eu-deu-fra", "na-usa-iad"),
+			want:   set("eu-deu-fra", "na-usa-iad"),
+		},
+		{
+			name:   "added region is planned",
+			config: set("EU-DEU-FRA", "as-jpn-hnd"),
+			state:  set("eu-deu-fra"),
+			want:   set("EU-DEU-FRA", "as-jpn-hnd"),
+		},
+		{
+			name:   "create plans the configured regions",
+			config: set("EU-DEU-FRA"),
+			state:  types.SetNull(types.StringType),
+			want:   set("EU-DEU-FRA"),
+		},
+		{
+			name:   "unknown region is planned",
+			config: types.SetValueMust(types.StringType, []attr.Value{types.StringUnknown()}),
+			state:  set("eu-deu-fra"),
+			want:   types.SetValueMust(types.StringType, []attr.Value{types.StringUnknown()}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.SetRequest{
+				ConfigValue: tt.config,
+				StateValue:  tt.state,
+				PlanValue:   tt.config,
+			}
+			resp := &planmodifier.SetResponse{PlanValue: tt.config}
+
+			NormalizedStringSet(Lowercase).PlanModifySet(context.Background(), req, resp)
+
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Errorf("plan = %v, want %v", resp.PlanValue, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlannedString(t *testing.T) {
+	tests := []struct {
+		name    string
+		planned types.String
+		stored  string
+		want    types.String
+	}{
+		{
+			name:    "normalized value keeps the planned value",
+			planned: types.StringValue("#EF4444"),
+			stored:  "#ef4444",
+			want:    types.StringValue("#EF4444"),
+		},
+		{
+			name:    "different value uses the stored value",
+			planned: types.StringValue("#EF4444"),
+			stored:  "#16a34a",
+			want:    types.StringValue("#16a34a"),
+		},
+		{
+			name:    "null plan uses the stored value",
+			planned: types.StringNull(),
+			stored:  "#ef4444",
+			want:    types.StringValue("#ef4444"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PlannedString(tt.planned, tt.stored, Lowercase); !got.Equal(tt.want) {
+				t.Errorf("PlannedString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -34,19 +35,24 @@ func NewAlertRuleResource() resource.Resource {
 
 // AlertRuleResource defines the resource implementation.
 type AlertRuleResource struct {
-	client *client.Client
+	client     *client.Client
+	logChanges bool
 }
 
 // AlertRuleResourceModel describes the resource data model.
 type AlertRuleResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	Event         types.String `tfsdk:"event"`
-	IntegrationID types.Int64  `tfsdk:"integration_id"`
-	RateLimit     types.Int64  `tfsdk:"rate_limit"`
-	EventSettings types.Object `tfsdk:"event_settings"`
-	ProjectID     types.Int64  `tfsdk:"project_id"`
-	CreatedAt     types.String `tfsdk:"created_at"`
-	UpdatedAt     types.String `tfsdk:"updated_at"`
+	ID                 types.String `tfsdk:"id"`
+	Event              types.String `tfsdk:"event"`
+	IntegrationID      types.Int64  `tfsdk:"integration_id"`
+	IntegrationName    types.String `tfsdk:"integration_name"`
+	IntegrationType    types.String `tfsdk:"integration_type"`
+	RateLimit          types.Int64  `tfsdk:"rate_limit"`
+	EventSettings      types.Object `tfsdk:"event_settings"`
+	EscalationPolicyID types.Int64  `tfsdk:"escalation_policy_id"`
+	ProjectID          types.Int64  `tfsdk:"project_id"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+	BaseURL            types.String `tfsdk:"base_url"`
 }
 
 type AlertEventSettingsModel struct {
@@ -94,6 +100,20 @@ func (r *AlertRuleResource) Schema(ctx context.Context, req resource.SchemaReque
 					int64planmodifier.RequiresReplace(),
 				},
 			},
+			"integration_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the integration alerts are sent to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"integration_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the integration alerts are sent to (e.g., slack, webhook)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"rate_limit": schema.Int64Attribute{
 				MarkdownDescription: "Rate limit in minutes (0, 5, 30, 60, 120, 360, 1440)",
 				Required:            true,
@@ -111,6 +131,10 @@ func (r *AlertRuleResource) Schema(ctx context.Context, req resource.SchemaReque
 					},
 				},
 			},
+			"escalation_policy_id": schema.Int64Attribute{
+				MarkdownDescription: "Optional ID of an escalation policy to notify when the alert is not acknowledged",
+				Optional:            true,
+			},
 			"project_id": schema.Int64Attribute{
 				MarkdownDescription: "Optional project ID to scope the alert rule to a specific project",
 				Optional:            true,
@@ -126,6 +150,7 @@ func (r *AlertRuleResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "Timestamp when the alert rule was last updated",
 				Computed:            true,
 			},
+			"base_url": baseURLAttribute(),
 		},
 	}
 }
@@ -145,6 +170,7 @@ func (r *AlertRuleResource) Configure(ctx context.Context, req resource.Configur
 	}
 
 	r.client = client
+	r.logChanges = client.LogChanges()
 }
 
 func (r *AlertRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -171,6 +197,10 @@ func (r *AlertRuleResource) Create(ctx context.Context, req resource.CreateReque
 		},
 	}
 
+	if !data.EscalationPolicyID.IsNull() {
+		policyID := int(data.EscalationPolicyID.ValueInt64())
+		rule.EscalationPolicyID = &policyID
+	}
 	if !data.ProjectID.IsNull() {
 		projectID := int(data.ProjectID.ValueInt64())
 		rule.ProjectID = &projectID
@@ -178,7 +208,8 @@ func (r *AlertRuleResource) Create(ctx context.Context, req resource.CreateReque
 
 	tflog.Debug(ctx, "Creating alert rule", map[string]any{"event": data.Event.ValueString()})
 
-	created, err := r.client.CreateAlertRule(ctx, rule)
+	api := scopedClient(r.client, data.BaseURL)
+	created, err := api.CreateAlertRule(ctx, rule)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create alert rule", err.Error())
 		return
@@ -191,13 +222,14 @@ func (r *AlertRuleResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Read back the alert rule to get all fields
-	fullRule, err := r.client.GetAlertRule(ctx, *created.ID)
+	fullRule, err := api.GetAlertRule(ctx, *created.ID)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read created alert rule", err.Error())
 		return
 	}
 
 	r.apiToTerraformModel(fullRule, &data)
+	r.readIntegrationSummary(ctx, &data, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -217,13 +249,21 @@ func (r *AlertRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	rule, err := r.client.GetAlertRule(ctx, id)
+	api := scopedClient(r.client, data.BaseURL)
+	rule, err := api.GetAlertRule(ctx, id)
+	if client.IsNotFound(err) {
+		// Deleted outside Terraform, remove it so that it's planned for creation
+		tflog.Warn(ctx, "Alert rule not found, removing from state", map[string]any{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read alert rule", err.Error())
 		return
 	}
 
 	r.apiToTerraformModel(rule, &data)
+	r.readIntegrationSummary(ctx, &data, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -251,12 +291,19 @@ func (r *AlertRuleResource) Update(ctx context.Context, req resource.UpdateReque
 		},
 	}
 
+	if !data.EscalationPolicyID.IsNull() {
+		policyID := int(data.EscalationPolicyID.ValueInt64())
+		rule.EscalationPolicyID = &policyID
+	}
 	if !data.ProjectID.IsNull() {
 		projectID := int(data.ProjectID.ValueInt64())
 		rule.ProjectID = &projectID
 	}
 
 	tflog.Debug(ctx, "Updating alert rule", map[string]any{"id": data.ID.ValueString()})
+	if r.logChanges {
+		logChangedAttributes(ctx, "Updating alert rule attributes", data.ID.ValueString(), req.Plan, req.State)
+	}
 
 	id, err := strconv.Atoi(data.ID.ValueString())
 	if err != nil {
@@ -264,13 +311,15 @@ func (r *AlertRuleResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	updated, err := r.client.UpdateAlertRule(ctx, id, rule)
+	api := scopedClient(r.client, data.BaseURL)
+	updated, err := api.UpdateAlertRule(ctx, id, rule)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update alert rule", err.Error())
 		return
 	}
 
 	r.apiToTerraformModel(updated, &data)
+	r.readIntegrationSummary(ctx, &data, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -290,7 +339,8 @@ func (r *AlertRuleResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	if err := r.client.DeleteAlertRule(ctx, id); err != nil {
+	api := scopedClient(r.client, data.BaseURL)
+	if err := api.DeleteAlertRule(ctx, id); err != nil {
 		resp.Diagnostics.AddError("Failed to delete alert rule", err.Error())
 		return
 	}
@@ -324,16 +374,37 @@ func (r *AlertRuleResource) apiToTerraformModel(rule *client.AlertRule, data *Al
 	// If EventSettings is empty, we don't overwrite data.EventSettings,
 	// which preserves the value from the plan/state
 
+	if rule.EscalationPolicyID != nil {
+		data.EscalationPolicyID = types.Int64Value(int64(*rule.EscalationPolicyID))
+	} else {
+		data.EscalationPolicyID = types.Int64Null()
+	}
+
 	if rule.ProjectID != nil {
 		data.ProjectID = types.Int64Value(int64(*rule.ProjectID))
 	} else {
 		data.ProjectID = types.Int64Null()
 	}
 
-	if rule.CreatedAt != nil {
-		data.CreatedAt = types.StringValue(*rule.CreatedAt)
-	}
-	if rule.UpdatedAt != nil {
-		data.UpdatedAt = types.StringValue(*rule.UpdatedAt)
+	data.CreatedAt = timestampValue(rule.CreatedAt, data.CreatedAt)
+	data.UpdatedAt = timestampValue(rule.UpdatedAt, data.UpdatedAt)
+}
+
+// readIntegrationSummary populates the human-readable details of the alert
+// rule's integration. Lookups are cached by the client across alert rules.
+func (r *AlertRuleResource) readIntegrationSummary(ctx context.Context, data *AlertRuleResourceModel, diags *diag.Diagnostics) {
+	api := scopedClient(r.client, data.BaseURL)
+	integration, err := api.LookupIntegration(ctx, int(data.IntegrationID.ValueInt64()))
+	if err != nil {
+		diags.AddWarning(
+			"Unable to read alert rule integration",
+			fmt.Sprintf("Failed to look up integration %d: %s", data.IntegrationID.ValueInt64(), err.Error()),
+		)
+		data.IntegrationName = types.StringNull()
+		data.IntegrationType = types.StringNull()
+		return
 	}
+
+	data.IntegrationName = types.StringValue(integration.Name)
+	data.IntegrationType = types.StringValue(integration.Type)
 }
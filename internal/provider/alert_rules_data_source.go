@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AlertRulesDataSource{}
+
+func NewAlertRulesDataSource() datasource.DataSource {
+	return &AlertRulesDataSource{}
+}
+
+// AlertRulesDataSource defines the data source implementation.
+type AlertRulesDataSource struct {
+	client *client.Client
+}
+
+// AlertRulesDataSourceModel describes the data source data model.
+type AlertRulesDataSourceModel struct {
+	Rules types.List `tfsdk:"rules"`
+}
+
+func (d *AlertRulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_rules"
+}
+
+func (d *AlertRulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists all Phare alert rules of the account, for example to export or audit the alerting configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The alert rules of the account",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the alert rule",
+							Computed:            true,
+						},
+						"event": schema.StringAttribute{
+							MarkdownDescription: "The event that triggers the alert rule",
+							Computed:            true,
+						},
+						"integration_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the integration alerts are sent to",
+							Computed:            true,
+						},
+						"rate_limit": schema.Int64Attribute{
+							MarkdownDescription: "Rate limit in minutes",
+							Computed:            true,
+						},
+						"event_settings": schema.SingleNestedAttribute{
+							MarkdownDescription: "Settings for when the alert triggers, null when not returned by the API",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "Trigger type",
+									Computed:            true,
+								},
+							},
+						},
+						"escalation_policy_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the escalation policy notified when the alert is not acknowledged",
+							Computed:            true,
+						},
+						"project_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the project the alert rule is scoped to",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the alert rule was created",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the alert rule was last updated",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AlertRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AlertRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AlertRulesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing alert rules")
+
+	rules, err := d.client.ListAlertRules(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list alert rules", err.Error())
+		return
+	}
+
+	ruleList, diags := alertRuleListValue(rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Rules = ruleList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// alertRuleEventSettingsAttrTypes returns the attribute types of the
+// event_settings object
+func alertRuleEventSettingsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type": types.StringType,
+	}
+}
+
+// alertRuleAttrTypes returns the attribute types of a rules list element
+func alertRuleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                   types.StringType,
+		"event":                types.StringType,
+		"integration_id":       types.Int64Type,
+		"rate_limit":           types.Int64Type,
+		"event_settings":       types.ObjectType{AttrTypes: alertRuleEventSettingsAttrTypes()},
+		"escalation_policy_id": types.Int64Type,
+		"project_id":           types.Int64Type,
+		"created_at":           types.StringType,
+		"updated_at":           types.StringType,
+	}
+}
+
+// alertRuleListValue converts API alert rules to a rules list value
+func alertRuleListValue(rules []client.AlertRule) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elements := make([]attr.Value, 0, len(rules))
+	for _, rule := range rules {
+		id := types.StringNull()
+		if rule.ID != nil {
+			id = types.StringValue(strconv.Itoa(*rule.ID))
+		}
+
+		eventSettings := types.ObjectNull(alertRuleEventSettingsAttrTypes())
+		if rule.EventSettings.Type != "" {
+			var objDiags diag.Diagnostics
+			eventSettings, objDiags = types.ObjectValue(alertRuleEventSettingsAttrTypes(), map[string]attr.Value{
+				"type": types.StringValue(rule.EventSettings.Type),
+			})
+			diags.Append(objDiags...)
+		}
+
+		ruleObj, objDiags := types.ObjectValue(alertRuleAttrTypes(), map[string]attr.Value{
+			"id":                   id,
+			"event":                types.StringValue(rule.Event),
+			"integration_id":       types.Int64Value(int64(rule.IntegrationID)),
+			"rate_limit":           types.Int64Value(int64(rule.RateLimit)),
+			"event_settings":       eventSettings,
+			"escalation_policy_id": int64PointerValue(rule.EscalationPolicyID),
+			"project_id":           int64PointerValue(rule.ProjectID),
+			"created_at":           types.StringPointerValue(rule.CreatedAt),
+			"updated_at":           types.StringPointerValue(rule.UpdatedAt),
+		})
+		diags.Append(objDiags...)
+		elements = append(elements, ruleObj)
+	}
+
+	list, listDiags := types.ListValue(types.ObjectType{AttrTypes: alertRuleAttrTypes()}, elements)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// int64PointerValue converts an optional API integer to an Int64 value
+func int64PointerValue(v *int) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(*v))
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+func TestAccAlertRulesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAlertRulesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListNotEmpty("data.phare_alert_rules.test", "rules"),
+					resource.TestCheckResourceAttrSet("data.phare_alert_rules.test", "rules.0.id"),
+					resource.TestCheckResourceAttrSet("data.phare_alert_rules.test", "rules.0.event"),
+					resource.TestCheckResourceAttrSet("data.phare_alert_rules.test", "rules.0.integration_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAlertRulesDataSourceConfig() string {
+	return testAccAlertRuleResourceConfig(64493, 0) + `
+data "phare_alert_rules" "test" {
+  depends_on = [phare_alert_rule.test]
+}
+`
+}
+
+func TestAlertRulesDataSourceStateMatchesSchema(t *testing.T) {
+	ctx := context.Background()
+	d := &AlertRulesDataSource{}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	id, policyID := 1, 7
+	rules := []client.AlertRule{
+		{
+			ID:                 &id,
+			Event:              "uptime.incident.created",
+			IntegrationID:      64493,
+			RateLimit:          5,
+			EventSettings:      client.AlertEventSettings{Type: "all"},
+			EscalationPolicyID: &policyID,
+		},
+		{
+			Event:         "uptime.monitor.deleted",
+			IntegrationID: 64493,
+		},
+	}
+
+	ruleList, diags := alertRuleListValue(rules)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags.Append(state.Set(ctx, &AlertRulesDataSourceModel{Rules: ruleList})...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := len(ruleList.Elements()); got != 2 {
+		t.Errorf("got %d rules, want 2", got)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// apiField maps an API validation error field to a resource attribute.
+// detail, when set, explains the error ahead of the API's own messages.
+type apiField struct {
+	path   path.Path
+	detail string
+}
+
+// addAPIError adds err to diags. Validation errors of fields present in
+// fields are reported as attribute errors, anything else as a general error.
+func addAPIError(diags *diag.Diagnostics, summary string, err error, fields map[string]apiField) {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Errors) == 0 {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	names := make([]string, 0, len(apiErr.Errors))
+	for name := range apiErr.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	unmapped := false
+	for _, name := range names {
+		field, ok := fields[name]
+		if !ok {
+			unmapped = true
+			continue
+		}
+
+		detail := strings.Join(apiErr.Errors[name], " ")
+		if field.detail != "" {
+			detail = field.detail + "\n\nAPI response: " + detail
+		}
+		diags.AddAttributeError(field.path, summary, detail)
+	}
+
+	if unmapped {
+		diags.AddError(summary, err.Error())
+	}
+}
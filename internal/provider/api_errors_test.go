@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+func TestAddAPIError(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		wantAttribute bool
+		wantGeneral   bool
+	}{
+		{
+			name: "timeframe rejected",
+			err: &client.APIError{
+				StatusCode: 422,
+				Message:    "The given data was invalid.",
+				Errors:     map[string][]string{"timeframe": {"The selected timeframe is invalid."}},
+			},
+			wantAttribute: true,
+		},
+		{
+			name: "wrapped timeframe rejection",
+			err: fmt.Errorf("failed to create status page: %w", &client.APIError{
+				StatusCode: 422,
+				Errors:     map[string][]string{"timeframe": {"The selected timeframe is invalid."}},
+			}),
+			wantAttribute: true,
+		},
+		{
+			name: "unmapped field",
+			err: &client.APIError{
+				StatusCode: 422,
+				Errors:     map[string][]string{"colors.operational": {"Invalid color."}},
+			},
+			wantGeneral: true,
+		},
+		{
+			name:        "not an API error",
+			err:         errors.New("failed to execute request"),
+			wantGeneral: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addAPIError(&diags, "Failed to create status page", tt.err, statusPageAPIFields)
+
+			var gotAttribute, gotGeneral bool
+			for _, d := range diags.Errors() {
+				withPath, ok := d.(diag.DiagnosticWithPath)
+				if !ok {
+					gotGeneral = true
+					continue
+				}
+
+				gotAttribute = true
+				if !withPath.Path().Equal(path.Root("timeframe")) {
+					t.Errorf("unexpected attribute path %s", withPath.Path())
+				}
+				if !strings.Contains(d.Detail(), "higher plan tier") {
+					t.Errorf("expected plan tier guidance, got %q", d.Detail())
+				}
+			}
+
+			if gotAttribute != tt.wantAttribute || gotGeneral != tt.wantGeneral {
+				t.Errorf("attribute error = %v, general error = %v, want %v and %v: %v",
+					gotAttribute, gotGeneral, tt.wantAttribute, tt.wantGeneral, diags)
+			}
+		})
+	}
+}
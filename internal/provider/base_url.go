@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// baseURLAttribute is the per-resource base_url attribute, which targets a
+// different Phare instance than the provider configuration
+func baseURLAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Phare API base URL to manage this resource in, overriding the provider `base_url`. " +
+			"The provider API token is used. Changing this forces a new resource to be created",
+		Optional: true,
+		Validators: []validator.String{
+			baseURLValidator{},
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// scopedClient returns api targeting baseURL when it is set, and api itself
+// otherwise. Only *client.Client can be retargeted, other implementations are
+// returned unchanged.
+func scopedClient[T any](api T, baseURL types.String) T {
+	if baseURL.IsNull() || baseURL.IsUnknown() {
+		return api
+	}
+
+	c, ok := any(api).(*client.Client)
+	if !ok {
+		return api
+	}
+
+	scoped, ok := any(c.ForBaseURL(baseURL.ValueString())).(T)
+	if !ok {
+		return api
+	}
+
+	return scoped
+}
+
+var _ validator.String = baseURLValidator{}
+
+// baseURLValidator checks that a value is an absolute http or https URL.
+type baseURLValidator struct{}
+
+func (v baseURLValidator) Description(ctx context.Context) string {
+	return "value must be an absolute http or https URL"
+}
+
+func (v baseURLValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an absolute `http` or `https` URL"
+}
+
+func (v baseURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Base URL",
+			fmt.Sprintf("%q is not an absolute http or https URL, e.g. https://api.phare.io", value),
+		)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+func TestBaseURLValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{
+			name:  "https URL",
+			value: types.StringValue("https://api.eu.phare.io"),
+		},
+		{
+			name:  "http URL with port",
+			value: types.StringValue("http://localhost:8080"),
+		},
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:  "unknown",
+			value: types.StringUnknown(),
+		},
+		{
+			name:      "missing scheme",
+			value:     types.StringValue("api.phare.io"),
+			wantError: true,
+		},
+		{
+			name:      "unsupported scheme",
+			value:     types.StringValue("ftp://api.phare.io"),
+			wantError: true,
+		},
+		{
+			name:      "missing host",
+			value:     types.StringValue("https://"),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("base_url"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+
+			baseURLValidator{}.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ValidateString() has error = %v, want %v: %v", resp.Diagnostics.HasError(), tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestScopedClient(t *testing.T) {
+	c, err := client.NewClient("token", "https://api.phare.io")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if got := scopedClient(c, types.StringNull()); got != c {
+		t.Error("scopedClient() with null base_url should return the provider client")
+	}
+	if got := scopedClient(c, types.StringUnknown()); got != c {
+		t.Error("scopedClient() with unknown base_url should return the provider client")
+	}
+
+	scoped := scopedClient(c, types.StringValue("https://api.eu.phare.io"))
+	if scoped == c {
+		t.Error("scopedClient() with base_url should return a different client")
+	}
+
+	var api client.MonitorAPI = c
+	if got := scopedClient(api, types.StringValue("https://api.eu.phare.io")); got != client.MonitorAPI(scoped) {
+		t.Error("scopedClient() through an interface should return the scoped client")
+	}
+
+	fake := newFakeMonitorAPI()
+	if got := scopedClient[client.MonitorAPI](fake, types.StringValue("https://api.eu.phare.io")); got != client.MonitorAPI(fake) {
+		t.Error("scopedClient() should return implementations other than *client.Client unchanged")
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxBodyLength is the maximum length of an http_request body, in characters
+const maxBodyLength = 500
+
+// readBodyFile returns the contents of an http_request body_file, checking
+// that they fit in the body length limit
+func readBodyFile(name string) (string, error) {
+	contents, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+
+	body := string(contents)
+	if length := utf8.RuneCountInString(body); length > maxBodyLength {
+		return "", fmt.Errorf("%s is %d characters long, the request body must be at most %d characters", name, length, maxBodyLength)
+	}
+
+	return body, nil
+}
+
+var _ validator.String = bodyFileValidator{}
+
+// bodyFileValidator checks that body_file can be read and fits in the body
+// length limit.
+type bodyFileValidator struct{}
+
+func (v bodyFileValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("file must exist and be at most %d characters long", maxBodyLength)
+}
+
+func (v bodyFileValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v bodyFileValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := readBodyFile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Body File", err.Error())
+	}
+}
+
+var _ planmodifier.String = bodyFromFilePlanModifier{}
+
+// bodyFromFilePlanModifier plans body as the contents of body_file when it is
+// set, so that edits to the file show up as a diff on body, and as null when
+// neither is configured.
+type bodyFromFilePlanModifier struct{}
+
+func (m bodyFromFilePlanModifier) Description(ctx context.Context) string {
+	return "Plans the body as the contents of body_file when it is set."
+}
+
+func (m bodyFromFilePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return "Plans the body as the contents of `body_file` when it is set."
+}
+
+func (m bodyFromFilePlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var bodyFile types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path.ParentPath().AtName("body_file"), &bodyFile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case bodyFile.IsUnknown():
+		resp.PlanValue = types.StringUnknown()
+	case bodyFile.IsNull():
+		resp.PlanValue = types.StringNull()
+	default:
+		body, err := readBodyFile(bodyFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path.ParentPath().AtName("body_file"), "Invalid Body File", err.Error())
+			return
+		}
+		resp.PlanValue = types.StringValue(body)
+	}
+}
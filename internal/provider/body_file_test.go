@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestReadBodyFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, contents string) string {
+		file := filepath.Join(dir, name)
+		if err := os.WriteFile(file, []byte(contents), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+		return file
+	}
+
+	tests := []struct {
+		name      string
+		file      string
+		want      string
+		wantError bool
+	}{
+		{
+			name: "JSON body",
+			file: writeFile("body.json", `{"ping": true}`),
+			want: `{"ping": true}`,
+		},
+		{
+			name: "limit counts characters",
+			file: writeFile("unicode.txt", strings.Repeat("é", maxBodyLength)),
+			want: strings.Repeat("é", maxBodyLength),
+		},
+		{
+			name:      "too long",
+			file:      writeFile("long.txt", strings.Repeat("a", maxBodyLength+1)),
+			wantError: true,
+		},
+		{
+			name:      "missing file",
+			file:      filepath.Join(dir, "missing.json"),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readBodyFile(tt.file)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("readBodyFile() error = %v, wantError %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("readBodyFile() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBodyFromFilePlanModifier(t *testing.T) {
+	ctx := context.Background()
+
+	file := filepath.Join(t.TempDir(), "body.json")
+	if err := os.WriteFile(file, []byte(`{"ping": true}`), 0o600); err != nil {
+		t.Fatalf("failed to write body file: %v", err)
+	}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"http_request": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"body":      schema.StringAttribute{Optional: true, Computed: true},
+					"body_file": schema.StringAttribute{Optional: true},
+				},
+			},
+		},
+	}
+	requestType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"body":      tftypes.String,
+		"body_file": tftypes.String,
+	}}
+
+	tests := []struct {
+		name     string
+		body     types.String
+		bodyFile tftypes.Value
+		want     types.String
+	}{
+		{
+			name:     "body from file",
+			body:     types.StringNull(),
+			bodyFile: tftypes.NewValue(tftypes.String, file),
+			want:     types.StringValue(`{"ping": true}`),
+		},
+		{
+			name:     "no body",
+			body:     types.StringNull(),
+			bodyFile: tftypes.NewValue(tftypes.String, nil),
+			want:     types.StringNull(),
+		},
+		{
+			name:     "unknown file",
+			body:     types.StringNull(),
+			bodyFile: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			want:     types.StringUnknown(),
+		},
+		{
+			// A configured body is left to the framework
+			name:     "configured body",
+			body:     types.StringValue("ping"),
+			bodyFile: tftypes.NewValue(tftypes.String, nil),
+			want:     types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bodyValue, err := tt.body.ToTerraformValue(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			plan := tfsdk.Plan{
+				Schema: s,
+				Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"http_request": tftypes.NewValue(requestType, map[string]tftypes.Value{
+						"body":      bodyValue,
+						"body_file": tt.bodyFile,
+					}),
+				}),
+			}
+
+			req := planmodifier.StringRequest{
+				Path:        path.Root("http_request").AtName("body"),
+				ConfigValue: tt.body,
+				PlanValue:   types.StringUnknown(),
+				Plan:        plan,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+			bodyFromFilePlanModifier{}.PlanModifyString(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Errorf("PlanValue = %s, want %s", resp.PlanValue, tt.want)
+			}
+		})
+	}
+}
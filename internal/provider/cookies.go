@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// cookieHeaderName is the request header the http_request cookies are sent in
+const cookieHeaderName = "Cookie"
+
+// buildCookieHeader encodes cookies as a Cookie header value, ordered by name
+// so that the header doesn't change between runs
+func buildCookieHeader(cookies map[string]string) string {
+	names := make([]string, 0, len(cookies))
+	for name := range cookies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = name + "=" + cookies[name]
+	}
+	return strings.Join(pairs, "; ")
+}
+
+// parseCookieHeader decodes a Cookie header value into a name to value map
+func parseCookieHeader(header string) map[string]string {
+	cookies := map[string]string{}
+	for _, pair := range strings.Split(header, ";") {
+		name, value, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if !found || name == "" {
+			continue
+		}
+		cookies[name] = value
+	}
+	return cookies
+}
+
+var _ validator.Object = cookiesValidator{}
+
+// cookiesValidator checks that http_request cookies aren't combined with a
+// Cookie header in headers.
+type cookiesValidator struct{}
+
+func (v cookiesValidator) Description(ctx context.Context) string {
+	return "cookies must not be set together with a Cookie header in headers"
+}
+
+func (v cookiesValidator) MarkdownDescription(ctx context.Context) string {
+	return "`cookies` must not be set together with a `Cookie` header in `headers`"
+}
+
+func (v cookiesValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attrs := req.ConfigValue.Attributes()
+	cookies, ok := attrs["cookies"].(basetypes.MapValue)
+	if !ok || cookies.IsNull() {
+		return
+	}
+	headers, ok := attrs["headers"].(basetypes.SetValue)
+	if !ok || headers.IsNull() || headers.IsUnknown() {
+		return
+	}
+
+	for _, header := range headers.Elements() {
+		headerObj, ok := header.(basetypes.ObjectValue)
+		if !ok {
+			continue
+		}
+		name, ok := headerObj.Attributes()["name"].(basetypes.StringValue)
+		if !ok || name.IsNull() || name.IsUnknown() {
+			continue
+		}
+
+		if strings.EqualFold(name.ValueString(), cookieHeaderName) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName("cookies"),
+				"Conflicting Cookie Configuration",
+				"cookies can't be combined with a Cookie header in headers. Move the cookies of the header into cookies.",
+			)
+			return
+		}
+	}
+}
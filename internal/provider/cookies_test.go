@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCookieHeaderRoundTrip(t *testing.T) {
+	cookies := map[string]string{"session": "abc123", "locale": "en-GB"}
+
+	header := buildCookieHeader(cookies)
+	if header != "locale=en-GB; session=abc123" {
+		t.Errorf("buildCookieHeader() = %q, want cookies ordered by name", header)
+	}
+
+	if got := parseCookieHeader(header); !maps.Equal(got, cookies) {
+		t.Errorf("parseCookieHeader() = %v, want %v", got, cookies)
+	}
+}
+
+func TestCookiesValidator(t *testing.T) {
+	headerType := types.ObjectType{AttrTypes: requestHeaderAttrTypes()}
+	cookieHeader := types.ObjectValueMust(requestHeaderAttrTypes(), map[string]attr.Value{
+		"name":  types.StringValue("cookie"),
+		"value": types.StringValue("session=abc"),
+	})
+
+	tests := []struct {
+		name      string
+		cookies   types.Map
+		headers   types.Set
+		wantError bool
+	}{
+		{
+			name:    "cookies only",
+			cookies: types.MapValueMust(types.StringType, map[string]attr.Value{"session": types.StringValue("abc")}),
+			headers: types.SetNull(headerType),
+		},
+		{
+			name:    "cookie header only",
+			cookies: types.MapNull(types.StringType),
+			headers: types.SetValueMust(headerType, []attr.Value{cookieHeader}),
+		},
+		{
+			name:      "cookies and cookie header",
+			cookies:   types.MapValueMust(types.StringType, map[string]attr.Value{"session": types.StringValue("abc")}),
+			headers:   types.SetValueMust(headerType, []attr.Value{cookieHeader}),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := map[string]attr.Value{}
+			for name, attrType := range httpRequestAttrTypes() {
+				attrs[name] = nullValueOf(t, attrType)
+			}
+			attrs["method"] = types.StringValue("GET")
+			attrs["url"] = types.StringValue("https://example.com")
+			attrs["cookies"] = tt.cookies
+			attrs["headers"] = tt.headers
+
+			req := validator.ObjectRequest{
+				Path:        path.Root("http_request"),
+				ConfigValue: types.ObjectValueMust(httpRequestAttrTypes(), attrs),
+			}
+			resp := &validator.ObjectResponse{}
+
+			cookiesValidator{}.ValidateObject(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ValidateObject() has error = %v, want %v: %v", resp.Diagnostics.HasError(), tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
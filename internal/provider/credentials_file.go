@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// credentialsFile is the JSON document referenced by the credentials_file
+// provider attribute
+type credentialsFile struct {
+	APIToken string `json:"api_token"`
+	BaseURL  string `json:"base_url"`
+}
+
+// loadCredentialsFile reads and validates a credentials file, expanding a
+// leading ~ to the user's home directory
+func loadCredentialsFile(path string) (*credentialsFile, error) {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to expand home directory: %w", err)
+		}
+		path = filepath.Join(home, rest)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials file: %w", err)
+	}
+
+	var creds credentialsFile
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials file %s as JSON: %w", path, err)
+	}
+
+	if creds.BaseURL != "" {
+		u, err := url.Parse(creds.BaseURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("credentials file %s has an invalid base_url %q", path, creds.BaseURL)
+		}
+	}
+
+	return &creds, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadCredentialsFile(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		want        credentialsFile
+		expectError string
+	}{
+		{
+			name:    "token only",
+			content: `{"api_token": "secret"}`,
+			want:    credentialsFile{APIToken: "secret"},
+		},
+		{
+			name:    "token and base url",
+			content: `{"api_token": "secret", "base_url": "https://api.example.com"}`,
+			want:    credentialsFile{APIToken: "secret", BaseURL: "https://api.example.com"},
+		},
+		{
+			name:        "malformed json",
+			content:     `api_token: secret`,
+			expectError: "as JSON",
+		},
+		{
+			name:        "invalid base url",
+			content:     `{"api_token": "secret", "base_url": "api.example.com"}`,
+			expectError: "invalid base_url",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "credentials.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatalf("failed to write credentials file: %v", err)
+			}
+
+			creds, err := loadCredentialsFile(path)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("expected error containing %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *creds != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, *creds)
+			}
+		})
+	}
+}
+
+func TestLoadCredentialsFileMissing(t *testing.T) {
+	_, err := loadCredentialsFile(filepath.Join(t.TempDir(), "missing.json"))
+	if err == nil {
+		t.Fatal("expected error for missing file")
+	}
+}
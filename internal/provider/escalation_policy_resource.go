@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EscalationPolicyResource{}
+var _ resource.ResourceWithImportState = &EscalationPolicyResource{}
+
+func NewEscalationPolicyResource() resource.Resource {
+	return &EscalationPolicyResource{}
+}
+
+// EscalationPolicyResource defines the resource implementation.
+type EscalationPolicyResource struct {
+	client *client.Client
+}
+
+// EscalationPolicyResourceModel describes the resource data model.
+type EscalationPolicyResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Steps     types.List   `tfsdk:"steps"`
+	ProjectID types.Int64  `tfsdk:"project_id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+	BaseURL   types.String `tfsdk:"base_url"`
+}
+
+type EscalationStepModel struct {
+	Delay         types.Int64 `tfsdk:"delay"`
+	IntegrationID types.Int64 `tfsdk:"integration_id"`
+}
+
+func (r *EscalationPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_escalation_policy"
+}
+
+func (r *EscalationPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Phare escalation policy that notifies integrations in order until an alert is acknowledged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the escalation policy",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the escalation policy (2-30 characters)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 30),
+				},
+			},
+			"steps": schema.ListNestedAttribute{
+				MarkdownDescription: "Ordered list of escalation steps, each notifying an integration after a delay",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"delay": schema.Int64Attribute{
+							MarkdownDescription: "Delay in minutes after the previous step before this step notifies",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"integration_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the integration to notify",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Optional project ID to scope the escalation policy to a specific project",
+				Optional:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the escalation policy was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the escalation policy was last updated",
+				Computed:            true,
+			},
+			"base_url": baseURLAttribute(),
+		},
+	}
+}
+
+func (r *EscalationPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EscalationPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EscalationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := r.terraformToAPIModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating escalation policy", map[string]any{"name": data.Name.ValueString()})
+
+	api := scopedClient(r.client, data.BaseURL)
+	created, err := api.CreateEscalationPolicy(ctx, policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create escalation policy", err.Error())
+		return
+	}
+
+	// Get the created policy ID
+	if created.ID == nil {
+		resp.Diagnostics.AddError("Failed to create escalation policy", "API did not return an escalation policy ID")
+		return
+	}
+
+	// Read back the escalation policy to get all fields
+	fullPolicy, err := api.GetEscalationPolicy(ctx, *created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read created escalation policy", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiToTerraformModel(fullPolicy, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EscalationPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EscalationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading escalation policy", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid escalation policy ID", fmt.Sprintf("Failed to parse escalation policy ID: %s", err.Error()))
+		return
+	}
+
+	api := scopedClient(r.client, data.BaseURL)
+	policy, err := api.GetEscalationPolicy(ctx, id)
+	if client.IsNotFound(err) {
+		// Deleted outside Terraform, remove it so that it's planned for creation
+		tflog.Warn(ctx, "Escalation policy not found, removing from state", map[string]any{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read escalation policy", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiToTerraformModel(policy, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EscalationPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EscalationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := r.terraformToAPIModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating escalation policy", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid escalation policy ID", fmt.Sprintf("Failed to parse escalation policy ID: %s", err.Error()))
+		return
+	}
+
+	api := scopedClient(r.client, data.BaseURL)
+	updated, err := api.UpdateEscalationPolicy(ctx, id, policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update escalation policy", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiToTerraformModel(updated, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EscalationPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EscalationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting escalation policy", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid escalation policy ID", fmt.Sprintf("Failed to parse escalation policy ID: %s", err.Error()))
+		return
+	}
+
+	api := scopedClient(r.client, data.BaseURL)
+	if err := api.DeleteEscalationPolicy(ctx, id); err != nil {
+		resp.Diagnostics.AddError("Failed to delete escalation policy", err.Error())
+		return
+	}
+}
+
+func (r *EscalationPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// terraformToAPIModel converts Terraform model to API client model
+func (r *EscalationPolicyResource) terraformToAPIModel(ctx context.Context, data *EscalationPolicyResourceModel) (*client.EscalationPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policy := &client.EscalationPolicy{
+		Name: data.Name.ValueString(),
+	}
+
+	if !data.ProjectID.IsNull() {
+		projectID := int(data.ProjectID.ValueInt64())
+		policy.ProjectID = &projectID
+	}
+
+	var steps []EscalationStepModel
+	diags.Append(data.Steps.ElementsAs(ctx, &steps, false)...)
+
+	policy.Steps = make([]client.EscalationStep, len(steps))
+	for i, s := range steps {
+		policy.Steps[i] = client.EscalationStep{
+			Delay:         int(s.Delay.ValueInt64()),
+			IntegrationID: int(s.IntegrationID.ValueInt64()),
+		}
+	}
+
+	return policy, diags
+}
+
+// apiToTerraformModel converts API client model to Terraform model
+func (r *EscalationPolicyResource) apiToTerraformModel(policy *client.EscalationPolicy, data *EscalationPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if policy.ID != nil {
+		data.ID = types.StringValue(fmt.Sprintf("%d", *policy.ID))
+	}
+	data.Name = types.StringValue(policy.Name)
+
+	if policy.ProjectID != nil {
+		data.ProjectID = types.Int64Value(int64(*policy.ProjectID))
+	} else {
+		data.ProjectID = types.Int64Null()
+	}
+
+	data.CreatedAt = timestampValue(policy.CreatedAt, data.CreatedAt)
+	data.UpdatedAt = timestampValue(policy.UpdatedAt, data.UpdatedAt)
+
+	stepAttrTypes := map[string]attr.Type{
+		"delay":          types.Int64Type,
+		"integration_id": types.Int64Type,
+	}
+
+	stepElements := make([]attr.Value, len(policy.Steps))
+	for i, s := range policy.Steps {
+		stepObj, diagObj := types.ObjectValue(
+			stepAttrTypes,
+			map[string]attr.Value{
+				"delay":          types.Int64Value(int64(s.Delay)),
+				"integration_id": types.Int64Value(int64(s.IntegrationID)),
+			},
+		)
+		diags.Append(diagObj...)
+		stepElements[i] = stepObj
+	}
+
+	stepList, diagList := types.ListValue(types.ObjectType{AttrTypes: stepAttrTypes}, stepElements)
+	diags.Append(diagList...)
+	data.Steps = stepList
+
+	return diags
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccEscalationPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEscalationPolicyResourceConfig(15),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_escalation_policy.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("TF Escalation Test"),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_escalation_policy.test",
+						tfjsonpath.New("steps").AtSliceIndex(1).AtMapKey("delay"),
+						knownvalue.Int64Exact(15),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_alert_rule.test",
+						tfjsonpath.New("escalation_policy_id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "phare_escalation_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccEscalationPolicyResourceConfig(30),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_escalation_policy.test",
+						tfjsonpath.New("steps").AtSliceIndex(1).AtMapKey("delay"),
+						knownvalue.Int64Exact(30),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccEscalationPolicyResourceConfig(delay int) string {
+	return fmt.Sprintf(`
+resource "phare_escalation_policy" "test" {
+  name = "TF Escalation Test"
+
+  steps = [
+    {
+      delay          = 0
+      integration_id = 64493
+    },
+    {
+      delay          = %[1]d
+      integration_id = 64493
+    }
+  ]
+}
+
+resource "phare_alert_rule" "test" {
+  event                = "uptime.incident.created"
+  integration_id       = 64493
+  rate_limit           = 0
+  escalation_policy_id = tonumber(phare_escalation_policy.test.id)
+
+  event_settings = {
+    type = "all"
+  }
+}
+`, delay)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+var _ client.MonitorAPI = &fakeMonitorAPI{}
+
+// fakeMonitorAPI is an in-memory client.MonitorAPI used by unit tests. It
+// records the calls it receives so tests can assert on the call flow.
+type fakeMonitorAPI struct {
+	mu       sync.Mutex
+	nextID   int
+	monitors map[int]client.Monitor
+	calls    []string
+	listErr  error
+}
+
+func newFakeMonitorAPI() *fakeMonitorAPI {
+	return &fakeMonitorAPI{
+		nextID:   1,
+		monitors: map[int]client.Monitor{},
+	}
+}
+
+func (f *fakeMonitorAPI) record(call string, id int) {
+	f.calls = append(f.calls, fmt.Sprintf("%s(%d)", call, id))
+}
+
+func (f *fakeMonitorAPI) CreateMonitor(ctx context.Context, monitor *client.Monitor) (*client.Monitor, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := f.nextID
+	f.nextID++
+	f.record("CreateMonitor", id)
+
+	created := *monitor
+	created.ID = &id
+	if created.Paused == nil {
+		created.Paused = boolPtr(false)
+	}
+	created.CreatedAt = stringPtr("2024-01-01T00:00:00Z")
+	created.UpdatedAt = stringPtr("2024-01-01T00:00:00Z")
+	f.monitors[id] = created
+
+	return &created, nil
+}
+
+func (f *fakeMonitorAPI) GetMonitor(ctx context.Context, id int) (*client.Monitor, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("GetMonitor", id)
+
+	monitor, ok := f.monitors[id]
+	if !ok {
+		return nil, &client.NotFoundError{Message: "Monitor not found"}
+	}
+	return &monitor, nil
+}
+
+func (f *fakeMonitorAPI) UpdateMonitor(ctx context.Context, id int, monitor *client.Monitor) (*client.Monitor, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("UpdateMonitor", id)
+
+	existing, ok := f.monitors[id]
+	if !ok {
+		return nil, &client.NotFoundError{Message: "Monitor not found"}
+	}
+
+	updated := *monitor
+	updated.ID = existing.ID
+	updated.CreatedAt = existing.CreatedAt
+	updated.UpdatedAt = stringPtr("2024-01-02T00:00:00Z")
+	if updated.Paused == nil {
+		updated.Paused = existing.Paused
+	}
+	f.monitors[id] = updated
+
+	return &updated, nil
+}
+
+func (f *fakeMonitorAPI) DeleteMonitor(ctx context.Context, id int) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("DeleteMonitor", id)
+
+	if _, ok := f.monitors[id]; !ok {
+		return &client.NotFoundError{Message: "Monitor not found"}
+	}
+	delete(f.monitors, id)
+	return nil
+}
+
+func (f *fakeMonitorAPI) ListMonitors(ctx context.Context) ([]client.Monitor, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	monitors := make([]client.Monitor, 0, len(f.monitors))
+	for _, monitor := range f.monitors {
+		monitors = append(monitors, monitor)
+	}
+	return monitors, nil
+}
+
+func (f *fakeMonitorAPI) FindMonitorByName(ctx context.Context, name string, limit int) (*client.Monitor, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	for _, monitor := range f.monitors {
+		if monitor.Name == name {
+			return &monitor, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeMonitorAPI) PauseMonitor(ctx context.Context, id int) error {
+	return f.setPaused("PauseMonitor", id, true)
+}
+
+func (f *fakeMonitorAPI) ResumeMonitor(ctx context.Context, id int) error {
+	return f.setPaused("ResumeMonitor", id, false)
+}
+
+func (f *fakeMonitorAPI) setPaused(call string, id int, paused bool) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record(call, id)
+
+	monitor, ok := f.monitors[id]
+	if !ok {
+		return &client.NotFoundError{Message: "Monitor not found"}
+	}
+	monitor.Paused = boolPtr(paused)
+	f.monitors[id] = monitor
+	return nil
+}
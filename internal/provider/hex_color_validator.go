@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// hexColorRegexp matches a #rgb or #rrggbb hex color code.
+var hexColorRegexp = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+
+var _ validator.String = hexColorValidator{}
+
+// hexColorValidator checks that a value is a #rgb or #rrggbb hex color code.
+type hexColorValidator struct{}
+
+func (v hexColorValidator) Description(ctx context.Context) string {
+	return "value must be a hex color code such as #16a34a or #fff"
+}
+
+func (v hexColorValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a hex color code such as `#16a34a` or `#fff`"
+}
+
+func (v hexColorValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if !hexColorRegexp.MatchString(value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Color",
+			fmt.Sprintf("%q is not a hex color code, use the #rrggbb or #rgb form, e.g. #16a34a", value),
+		)
+	}
+}
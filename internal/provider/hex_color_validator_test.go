@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHexColorValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{
+			name:  "six digits",
+			value: types.StringValue("#16a34a"),
+		},
+		{
+			name:  "three digits",
+			value: types.StringValue("#fff"),
+		},
+		{
+			name:  "upper case",
+			value: types.StringValue("#EF4444"),
+		},
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:  "unknown",
+			value: types.StringUnknown(),
+		},
+		{
+			name:      "missing hash",
+			value:     types.StringValue("16a34a"),
+			wantError: true,
+		},
+		{
+			name:      "four digits",
+			value:     types.StringValue("#16a3"),
+			wantError: true,
+		},
+		{
+			name:      "eight digits",
+			value:     types.StringValue("#16a34aff"),
+			wantError: true,
+		},
+		{
+			name:      "non hex digit",
+			value:     types.StringValue("#16g34a"),
+			wantError: true,
+		},
+		{
+			name:      "color name",
+			value:     types.StringValue("green"),
+			wantError: true,
+		},
+		{
+			name:      "empty",
+			value:     types.StringValue(""),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("colors").AtName("operational"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+
+			hexColorValidator{}.ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Errorf("HasError() = %v, want %v: %v", got, tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
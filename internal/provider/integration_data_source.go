@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IntegrationDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &IntegrationDataSource{}
+
+func NewIntegrationDataSource() datasource.DataSource {
+	return &IntegrationDataSource{}
+}
+
+// IntegrationDataSource defines the data source implementation.
+type IntegrationDataSource struct {
+	client *client.Client
+}
+
+// IntegrationDataSourceModel describes the data source data model.
+type IntegrationDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Healthy   types.Bool   `tfsdk:"healthy"`
+	Settings  types.Object `tfsdk:"settings"`
+	ProjectID types.Int64  `tfsdk:"project_id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// integrationPublicSettingsAttrTypes lists the settings exposed by the data
+// source, secret and webhook_url are deliberately left out.
+func integrationPublicSettingsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"url":   types.StringType,
+		"email": types.StringType,
+	}
+}
+
+func (d *IntegrationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (d *IntegrationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves an existing Phare notification integration by ID or name, for alert rules referencing integrations managed elsewhere.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the integration. Exactly one of `id` or `name` must be set",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the integration. Exactly one of `id` or `name` must be set, the name must match a single integration",
+				Optional:            true,
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the integration (e.g., `slack`, `webhook`, `pagerduty`)",
+				Computed:            true,
+			},
+			"healthy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the integration is currently healthy",
+				Computed:            true,
+			},
+			"settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Non-sensitive settings of the integration, the secret and webhook URL are never exposed",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "URL the integration sends notifications to",
+						Computed:            true,
+					},
+					"email": schema.StringAttribute{
+						MarkdownDescription: "Email address the integration sends notifications to",
+						Computed:            true,
+					},
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the project this integration belongs to",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the integration was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the integration was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *IntegrationDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *IntegrationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IntegrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IntegrationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var integration *client.Integration
+	if !data.ID.IsNull() {
+		tflog.Debug(ctx, "Reading integration", map[string]any{"id": data.ID.ValueString()})
+
+		id, err := strconv.Atoi(data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid integration ID", fmt.Sprintf("Failed to parse integration ID: %s", err.Error()))
+			return
+		}
+
+		integration, err = d.client.GetIntegration(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read integration", err.Error())
+			return
+		}
+	} else {
+		tflog.Debug(ctx, "Looking up integration by name", map[string]any{"name": data.Name.ValueString()})
+
+		integrations, err := d.client.ListIntegrations(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list integrations", err.Error())
+			return
+		}
+
+		integration, err = findIntegrationByName(integrations, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Failed to find integration", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(integrationToModel(integration, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findIntegrationByName returns the single integration with the given name.
+// When several integrations share the name, the error lists their IDs and
+// types so that one of them can be selected by id.
+func findIntegrationByName(integrations []client.Integration, name string) (*client.Integration, error) {
+	var matches []client.Integration
+	for _, integration := range integrations {
+		if integration.Name == name {
+			matches = append(matches, integration)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no integration named %q was found", name)
+	case 1:
+		return &matches[0], nil
+	default:
+		candidates := make([]string, 0, len(matches))
+		for _, integration := range matches {
+			if integration.ID != nil {
+				candidates = append(candidates, fmt.Sprintf("%d (%s)", *integration.ID, integration.Type))
+			}
+		}
+		return nil, fmt.Errorf("%d integrations are named %q, use id to select one of them: %s",
+			len(matches), name, strings.Join(candidates, ", "))
+	}
+}
+
+// integrationToModel converts an API integration to the data source model
+func integrationToModel(integration *client.Integration, data *IntegrationDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if integration.ID != nil {
+		data.ID = types.StringValue(strconv.Itoa(*integration.ID))
+	}
+
+	data.Name = types.StringValue(integration.Name)
+	data.Type = types.StringValue(integration.Type)
+	data.Healthy = types.BoolValue(integration.Healthy)
+	data.ProjectID = int64PointerValue(integration.ProjectID)
+	data.CreatedAt = timestampValue(integration.CreatedAt, data.CreatedAt)
+	data.UpdatedAt = timestampValue(integration.UpdatedAt, data.UpdatedAt)
+
+	data.Settings = types.ObjectNull(integrationPublicSettingsAttrTypes())
+	if integration.Settings != nil {
+		settings, diagObj := types.ObjectValue(integrationPublicSettingsAttrTypes(), map[string]attr.Value{
+			"url":   types.StringPointerValue(integration.Settings.URL),
+			"email": types.StringPointerValue(integration.Settings.Email),
+		})
+		diags.Append(diagObj...)
+		data.Settings = settings
+	}
+
+	return diags
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+func TestAccIntegrationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccIntegrationDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.phare_integration.test", "id", "64493"),
+					resource.TestCheckResourceAttrSet("data.phare_integration.test", "name"),
+					resource.TestCheckResourceAttrSet("data.phare_integration.test", "type"),
+					resource.TestCheckResourceAttr("data.phare_integration.by_name", "id", "64493"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIntegrationDataSourceConfig() string {
+	return `
+data "phare_integration" "test" {
+  id = "64493"
+}
+
+data "phare_integration" "by_name" {
+  name = data.phare_integration.test.name
+}
+`
+}
+
+func TestIntegrationDataSourceStateMatchesSchema(t *testing.T) {
+	ctx := context.Background()
+	d := &IntegrationDataSource{}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	id := 7
+	integration := &client.Integration{
+		ID:        &id,
+		Name:      "On-call",
+		Type:      "slack",
+		Healthy:   true,
+		CreatedAt: stringPtr("2024-01-01T00:00:00Z"),
+	}
+
+	data := IntegrationDataSourceModel{ID: types.StringValue("7")}
+	if diags := integrationToModel(integration, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !data.ProjectID.IsNull() {
+		t.Errorf("project_id = %s, want null", data.ProjectID)
+	}
+	if !data.UpdatedAt.IsNull() {
+		t.Errorf("updated_at = %s, want null", data.UpdatedAt)
+	}
+	if !data.Settings.IsNull() {
+		t.Errorf("settings = %s, want null", data.Settings)
+	}
+}
+
+func TestIntegrationDataSourceNeverExposesSecrets(t *testing.T) {
+	ctx := context.Background()
+	d := &IntegrationDataSource{}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	settingsAttr, ok := schemaResp.Schema.Attributes["settings"].(schema.SingleNestedAttribute)
+	if !ok {
+		t.Fatalf("settings attribute is %T, want schema.SingleNestedAttribute", schemaResp.Schema.Attributes["settings"])
+	}
+	for _, name := range []string{"secret", "webhook_url"} {
+		if _, ok := settingsAttr.Attributes[name]; ok {
+			t.Errorf("settings exposes %q", name)
+		}
+	}
+
+	id := 7
+	integration := &client.Integration{
+		ID:   &id,
+		Name: "Deploys",
+		Type: "webhook",
+		Settings: &client.IntegrationSettings{
+			URL:        stringPtr("https://hooks.example.com/phare"),
+			Secret:     stringPtr("s3cr3t"),
+			WebhookURL: stringPtr("https://hooks.slack.com/services/T000/B000/XXXX"),
+			Email:      stringPtr("ops@example.com"),
+		},
+	}
+
+	data := IntegrationDataSourceModel{ID: types.StringValue("7")}
+	if diags := integrationToModel(integration, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	rendered := state.Raw.String()
+	for _, secret := range []string{"s3cr3t", "hooks.slack.com"} {
+		if strings.Contains(rendered, secret) {
+			t.Errorf("state contains %q: %s", secret, rendered)
+		}
+	}
+	for _, value := range []string{"https://hooks.example.com/phare", "ops@example.com"} {
+		if !strings.Contains(rendered, value) {
+			t.Errorf("state does not contain %q: %s", value, rendered)
+		}
+	}
+}
+
+func TestFindIntegrationByName(t *testing.T) {
+	id1, id2, id3 := 1, 2, 3
+	integrations := []client.Integration{
+		{ID: &id1, Name: "Ops", Type: "slack"},
+		{ID: &id2, Name: "On-call", Type: "slack"},
+		{ID: &id3, Name: "On-call", Type: "webhook"},
+	}
+
+	tests := []struct {
+		name      string
+		lookup    string
+		wantID    int
+		wantError string
+	}{
+		{name: "unique name", lookup: "Ops", wantID: 1},
+		{name: "ambiguous name", lookup: "On-call", wantError: "2 (slack), 3 (webhook)"},
+		{name: "unknown name", lookup: "Email", wantError: "no integration named"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			integration, err := findIntegrationByName(integrations, tt.lookup)
+			if tt.wantError != "" {
+				if err == nil {
+					t.Fatalf("expected error, got integration %d", *integration.ID)
+				}
+				if !strings.Contains(err.Error(), tt.wantError) {
+					t.Errorf("error = %q, want it to contain %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *integration.ID != tt.wantID {
+				t.Errorf("integration id = %d, want %d", *integration.ID, tt.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,451 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IntegrationResource{}
+var _ resource.ResourceWithImportState = &IntegrationResource{}
+var _ resource.ResourceWithValidateConfig = &IntegrationResource{}
+
+// integrationTypes lists the integration types that can be managed
+var integrationTypes = []string{"slack", "webhook", "email"}
+
+// integrationRequiredSettings lists the settings each integration type requires
+var integrationRequiredSettings = map[string][]string{
+	"slack":   {"webhook_url"},
+	"webhook": {"url"},
+	"email":   {"email"},
+}
+
+// integrationOptionalSettings lists the settings an integration type accepts
+// on top of its required settings
+var integrationOptionalSettings = map[string][]string{
+	"webhook": {"secret"},
+}
+
+func NewIntegrationResource() resource.Resource {
+	return &IntegrationResource{}
+}
+
+// IntegrationResource defines the resource implementation.
+type IntegrationResource struct {
+	client *client.Client
+}
+
+// IntegrationResourceModel describes the resource data model.
+type IntegrationResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Settings  types.Object `tfsdk:"settings"`
+	Healthy   types.Bool   `tfsdk:"healthy"`
+	ProjectID types.Int64  `tfsdk:"project_id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+	BaseURL   types.String `tfsdk:"base_url"`
+}
+
+type IntegrationSettingsModel struct {
+	URL        types.String `tfsdk:"url"`
+	Secret     types.String `tfsdk:"secret"`
+	WebhookURL types.String `tfsdk:"webhook_url"`
+	Email      types.String `tfsdk:"email"`
+}
+
+func integrationSettingsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"url":         types.StringType,
+		"secret":      types.StringType,
+		"webhook_url": types.StringType,
+		"email":       types.StringType,
+	}
+}
+
+func (r *IntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (r *IntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Phare notification integration that alert rules and escalation policies deliver notifications to.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the integration",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the integration (2-30 characters)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 30),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the integration: `slack`, `webhook` or `email`. Changing this forces a new resource to be created",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(integrationTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Type-specific settings of the integration",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "URL the `webhook` integration sends notifications to",
+						Optional:            true,
+						Validators: []validator.String{
+							IsAbsoluteHTTPURL(),
+						},
+					},
+					"secret": schema.StringAttribute{
+						MarkdownDescription: "Secret used to sign the `webhook` integration requests. The API doesn't return it, so it isn't restored on import",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"webhook_url": schema.StringAttribute{
+						MarkdownDescription: "Incoming webhook URL of the `slack` integration. The API doesn't return it, so it isn't restored on import",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							IsAbsoluteHTTPURL(),
+						},
+					},
+					"email": schema.StringAttribute{
+						MarkdownDescription: "Address the `email` integration sends notifications to",
+						Optional:            true,
+					},
+				},
+			},
+			"healthy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the integration is currently healthy",
+				Computed:            true,
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Optional project ID to scope the integration to a specific project",
+				Optional:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the integration was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the integration was last updated",
+				Computed:            true,
+			},
+			"base_url": baseURLAttribute(),
+		},
+	}
+}
+
+func (r *IntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IntegrationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsNull() || data.Type.IsUnknown() || data.Settings.IsNull() || data.Settings.IsUnknown() {
+		return
+	}
+
+	integrationType := data.Type.ValueString()
+	required := integrationRequiredSettings[integrationType]
+	allowed := append(slices.Clone(required), integrationOptionalSettings[integrationType]...)
+
+	settings := data.Settings.Attributes()
+	for _, name := range slices.Sorted(maps.Keys(settings)) {
+		value := settings[name]
+		if slices.Contains(required, name) && value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("settings").AtName(name),
+				"Missing Integration Setting",
+				fmt.Sprintf("settings.%s is required for %s integrations", name, integrationType),
+			)
+		}
+		if !slices.Contains(allowed, name) && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("settings").AtName(name),
+				"Invalid Integration Setting",
+				fmt.Sprintf("settings.%s is not supported by %s integrations", name, integrationType),
+			)
+		}
+	}
+}
+
+func (r *IntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IntegrationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integration, diags := r.terraformToAPIModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating integration", map[string]any{"name": data.Name.ValueString(), "type": data.Type.ValueString()})
+
+	api := scopedClient(r.client, data.BaseURL)
+	created, err := api.CreateIntegration(ctx, integration)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create integration", err.Error())
+		return
+	}
+
+	// Get the created integration ID
+	if created.ID == nil {
+		resp.Diagnostics.AddError("Failed to create integration", "API did not return an integration ID")
+		return
+	}
+
+	// Read back the integration to get all fields
+	fullIntegration, err := api.GetIntegration(ctx, *created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read created integration", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiToTerraformModel(ctx, fullIntegration, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IntegrationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading integration", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid integration ID", fmt.Sprintf("Failed to parse integration ID: %s", err.Error()))
+		return
+	}
+
+	api := scopedClient(r.client, data.BaseURL)
+	integration, err := api.GetIntegration(ctx, id)
+	if client.IsNotFound(err) {
+		// Deleted outside Terraform, remove it so that it's planned for creation
+		tflog.Warn(ctx, "Integration not found, removing from state", map[string]any{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read integration", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiToTerraformModel(ctx, integration, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IntegrationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integration, diags := r.terraformToAPIModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating integration", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid integration ID", fmt.Sprintf("Failed to parse integration ID: %s", err.Error()))
+		return
+	}
+
+	api := scopedClient(r.client, data.BaseURL)
+	updated, err := api.UpdateIntegration(ctx, id, integration)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update integration", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiToTerraformModel(ctx, updated, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IntegrationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting integration", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid integration ID", fmt.Sprintf("Failed to parse integration ID: %s", err.Error()))
+		return
+	}
+
+	api := scopedClient(r.client, data.BaseURL)
+	if err := api.DeleteIntegration(ctx, id); err != nil {
+		resp.Diagnostics.AddError("Failed to delete integration", err.Error())
+		return
+	}
+}
+
+func (r *IntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// terraformToAPIModel converts Terraform model to API client model
+func (r *IntegrationResource) terraformToAPIModel(ctx context.Context, data *IntegrationResourceModel) (*client.Integration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	integration := &client.Integration{
+		Name: data.Name.ValueString(),
+		Type: data.Type.ValueString(),
+	}
+
+	if !data.ProjectID.IsNull() {
+		projectID := int(data.ProjectID.ValueInt64())
+		integration.ProjectID = &projectID
+	}
+
+	var settings IntegrationSettingsModel
+	diags.Append(data.Settings.As(ctx, &settings, basetypes.ObjectAsOptions{})...)
+
+	integration.Settings = &client.IntegrationSettings{
+		URL:        settings.URL.ValueStringPointer(),
+		Secret:     settings.Secret.ValueStringPointer(),
+		WebhookURL: settings.WebhookURL.ValueStringPointer(),
+		Email:      settings.Email.ValueStringPointer(),
+	}
+
+	return integration, diags
+}
+
+// apiToTerraformModel converts API client model to Terraform model. The API
+// doesn't return the secret settings, so their prior values are kept.
+func (r *IntegrationResource) apiToTerraformModel(ctx context.Context, integration *client.Integration, data *IntegrationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if integration.ID != nil {
+		data.ID = types.StringValue(strconv.Itoa(*integration.ID))
+	}
+	data.Name = types.StringValue(integration.Name)
+	data.Type = types.StringValue(integration.Type)
+	data.Healthy = types.BoolValue(integration.Healthy)
+	data.ProjectID = int64PointerValue(integration.ProjectID)
+	data.CreatedAt = timestampValue(integration.CreatedAt, data.CreatedAt)
+	data.UpdatedAt = timestampValue(integration.UpdatedAt, data.UpdatedAt)
+
+	prior := IntegrationSettingsModel{
+		URL:        types.StringNull(),
+		Secret:     types.StringNull(),
+		WebhookURL: types.StringNull(),
+		Email:      types.StringNull(),
+	}
+	if !data.Settings.IsNull() && !data.Settings.IsUnknown() {
+		diags.Append(data.Settings.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+	}
+
+	apiSettings := integration.Settings
+	if apiSettings == nil {
+		apiSettings = &client.IntegrationSettings{}
+	}
+
+	settings, diagObj := types.ObjectValue(integrationSettingsAttrTypes(), map[string]attr.Value{
+		"url":         types.StringPointerValue(apiSettings.URL),
+		"secret":      writeOnlyValue(apiSettings.Secret, prior.Secret),
+		"webhook_url": writeOnlyValue(apiSettings.WebhookURL, prior.WebhookURL),
+		"email":       types.StringPointerValue(apiSettings.Email),
+	})
+	diags.Append(diagObj...)
+	data.Settings = settings
+
+	return diags
+}
+
+// writeOnlyValue returns the value returned by the API, or the prior value
+// for settings the API doesn't return
+func writeOnlyValue(value *string, prior types.String) types.String {
+	if value != nil {
+		return types.StringValue(*value)
+	}
+	if prior.IsUnknown() {
+		return types.StringNull()
+	}
+	return prior
+}
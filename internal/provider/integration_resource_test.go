@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+func TestAccIntegrationResource(t *testing.T) {
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIntegrationResourceConfig("https://example.com/phare/hook"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_integration.test",
+						tfjsonpath.New("type"),
+						knownvalue.StringExact("webhook"),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_integration.test",
+						tfjsonpath.New("settings").AtMapKey("url"),
+						knownvalue.StringExact("https://example.com/phare/hook"),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "phare_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API doesn't return the secret settings
+				ImportStateVerifyIgnore: []string{"settings.secret"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccIntegrationResourceConfig("https://example.com/phare/hook-v2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_integration.test",
+						tfjsonpath.New("settings").AtMapKey("url"),
+						knownvalue.StringExact("https://example.com/phare/hook-v2"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccIntegrationResourceConfig(url string) string {
+	return fmt.Sprintf(`
+resource "phare_integration" "test" {
+  name = "TF Test Webhook"
+  type = "webhook"
+
+  settings = {
+    url    = %[1]q
+    secret = "tf-test-secret"
+  }
+}
+`, url)
+}
+
+func TestIntegrationResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &IntegrationResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name            string
+		integrationType string
+		settings        map[string]attr.Value
+		wantErrors      int
+	}{
+		{
+			name:            "webhook with url and secret",
+			integrationType: "webhook",
+			settings: map[string]attr.Value{
+				"url":    types.StringValue("https://example.com/hook"),
+				"secret": types.StringValue("secret"),
+			},
+		},
+		{
+			name:            "webhook without url",
+			integrationType: "webhook",
+			settings: map[string]attr.Value{
+				"secret": types.StringValue("secret"),
+			},
+			wantErrors: 1,
+		},
+		{
+			name:            "slack with webhook_url",
+			integrationType: "slack",
+			settings: map[string]attr.Value{
+				"webhook_url": types.StringValue("https://hooks.slack.com/services/T0/B0/X"),
+			},
+		},
+		{
+			name:            "email with webhook settings",
+			integrationType: "email",
+			settings: map[string]attr.Value{
+				"email":  types.StringValue("ops@example.com"),
+				"url":    types.StringValue("https://example.com/hook"),
+				"secret": types.StringValue("secret"),
+			},
+			wantErrors: 2,
+		},
+		{
+			name:            "unknown required setting",
+			integrationType: "email",
+			settings: map[string]attr.Value{
+				"email": types.StringUnknown(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := map[string]attr.Value{
+				"url":         types.StringNull(),
+				"secret":      types.StringNull(),
+				"webhook_url": types.StringNull(),
+				"email":       types.StringNull(),
+			}
+			for name, value := range tt.settings {
+				settings[name] = value
+			}
+
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			state := tfsdk.State(config)
+			diags := state.Set(ctx, &IntegrationResourceModel{
+				ID:        types.StringNull(),
+				Name:      types.StringValue("Ops"),
+				Type:      types.StringValue(tt.integrationType),
+				Settings:  types.ObjectValueMust(integrationSettingsAttrTypes(), settings),
+				Healthy:   types.BoolNull(),
+				ProjectID: types.Int64Null(),
+				CreatedAt: types.StringNull(),
+				UpdatedAt: types.StringNull(),
+				BaseURL:   types.StringNull(),
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			config.Raw = state.Raw
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Errorf("ErrorsCount() = %d, want %d: %v", got, tt.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestIntegrationResourceKeepsSecretSettings(t *testing.T) {
+	ctx := context.Background()
+	r := &IntegrationResource{}
+
+	id := 1
+	url := "https://example.com/hook"
+	data := IntegrationResourceModel{
+		Settings: types.ObjectValueMust(integrationSettingsAttrTypes(), map[string]attr.Value{
+			"url":         types.StringValue(url),
+			"secret":      types.StringValue("secret"),
+			"webhook_url": types.StringNull(),
+			"email":       types.StringNull(),
+		}),
+	}
+
+	diags := r.apiToTerraformModel(ctx, &client.Integration{
+		ID:       &id,
+		Name:     "Deploy hook",
+		Type:     "webhook",
+		Settings: &client.IntegrationSettings{URL: &url},
+	}, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	settings := data.Settings.Attributes()
+	if got := settings["secret"]; !got.Equal(types.StringValue("secret")) {
+		t.Errorf("settings.secret = %s, want the prior value", got)
+	}
+	if got := settings["url"]; !got.Equal(types.StringValue(url)) {
+		t.Errorf("settings.url = %s, want %q", got, url)
+	}
+}
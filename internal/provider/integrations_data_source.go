@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IntegrationsDataSource{}
+
+func NewIntegrationsDataSource() datasource.DataSource {
+	return &IntegrationsDataSource{}
+}
+
+// IntegrationsDataSource defines the data source implementation.
+type IntegrationsDataSource struct {
+	client *client.Client
+}
+
+// IntegrationsDataSourceModel describes the data source data model.
+type IntegrationsDataSourceModel struct {
+	Type         types.String `tfsdk:"type"`
+	Integrations types.List   `tfsdk:"integrations"`
+}
+
+func (d *IntegrationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integrations"
+}
+
+func (d *IntegrationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Phare notification integrations, optionally filtered by type.",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return integrations of this type (e.g., `slack`, `webhook`, `pagerduty`)",
+				Optional:            true,
+			},
+			"integrations": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching integrations",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The unique identifier of the integration",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the integration",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the integration",
+							Computed:            true,
+						},
+						"healthy": schema.BoolAttribute{
+							MarkdownDescription: "Whether the integration is currently healthy",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IntegrationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IntegrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IntegrationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing integrations", map[string]any{"type": data.Type.ValueString()})
+
+	integrations, err := d.client.ListIntegrations(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list integrations", err.Error())
+		return
+	}
+
+	integrationAttrTypes := map[string]attr.Type{
+		"id":      types.Int64Type,
+		"name":    types.StringType,
+		"type":    types.StringType,
+		"healthy": types.BoolType,
+	}
+
+	var elements []attr.Value
+	for _, integration := range integrations {
+		if !data.Type.IsNull() && integration.Type != data.Type.ValueString() {
+			continue
+		}
+
+		var id types.Int64
+		if integration.ID != nil {
+			id = types.Int64Value(int64(*integration.ID))
+		} else {
+			id = types.Int64Null()
+		}
+
+		integrationObj, diagObj := types.ObjectValue(
+			integrationAttrTypes,
+			map[string]attr.Value{
+				"id":      id,
+				"name":    types.StringValue(integration.Name),
+				"type":    types.StringValue(integration.Type),
+				"healthy": types.BoolValue(integration.Healthy),
+			},
+		)
+		resp.Diagnostics.Append(diagObj...)
+		elements = append(elements, integrationObj)
+	}
+
+	integrationList, diagList := types.ListValue(types.ObjectType{AttrTypes: integrationAttrTypes}, elements)
+	resp.Diagnostics.Append(diagList...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Integrations = integrationList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIntegrationsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccIntegrationsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.phare_integrations.test", "integrations.#"),
+					resource.TestCheckResourceAttr("data.phare_integrations.test", "integrations.0.type", "webhook"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIntegrationsDataSourceConfig() string {
+	return `
+data "phare_integrations" "test" {
+  type = "webhook"
+}
+`
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logChangedAttributes logs the names of the attributes changed by an update
+// at INFO level, for correlating applies with changes in Phare. Only names
+// are logged so that sensitive values never reach the logs.
+func logChangedAttributes(ctx context.Context, msg string, id string, plan tfsdk.Plan, state tfsdk.State) {
+	tflog.Info(ctx, msg, map[string]any{
+		"id":      id,
+		"changed": changedAttributes(plan.Raw, state.Raw),
+	})
+}
+
+// changedAttributes returns the sorted names of the top-level attributes
+// whose planned value differs from the state. Unknown planned values are
+// computed by the API and aren't reported.
+func changedAttributes(plan tftypes.Value, state tftypes.Value) []string {
+	var planned, current map[string]tftypes.Value
+	if err := plan.As(&planned); err != nil {
+		return nil
+	}
+	if err := state.As(&current); err != nil {
+		return nil
+	}
+
+	changed := []string{}
+	for name, value := range planned {
+		if !value.IsKnown() {
+			continue
+		}
+		if prior, ok := current[name]; !ok || !value.Equal(prior) {
+			changed = append(changed, name)
+		}
+	}
+	slices.Sort(changed)
+
+	return changed
+}
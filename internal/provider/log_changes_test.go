@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestChangedAttributes(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":         tftypes.String,
+		"name":       tftypes.String,
+		"interval":   tftypes.Number,
+		"updated_at": tftypes.String,
+	}}
+
+	state := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.String, "1"),
+		"name":       tftypes.NewValue(tftypes.String, "api"),
+		"interval":   tftypes.NewValue(tftypes.Number, 60),
+		"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+	})
+	plan := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.String, "1"),
+		"name":       tftypes.NewValue(tftypes.String, "api-v2"),
+		"interval":   tftypes.NewValue(tftypes.Number, 120),
+		"updated_at": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+
+	want := []string{"interval", "name"}
+	if got := changedAttributes(plan, state); !slices.Equal(got, want) {
+		t.Errorf("changedAttributes() = %v, want %v", got, want)
+	}
+}
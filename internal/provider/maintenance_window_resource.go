@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MaintenanceWindowResource{}
+var _ resource.ResourceWithImportState = &MaintenanceWindowResource{}
+var _ resource.ResourceWithValidateConfig = &MaintenanceWindowResource{}
+
+func NewMaintenanceWindowResource() resource.Resource {
+	return &MaintenanceWindowResource{}
+}
+
+// MaintenanceWindowResource defines the resource implementation.
+type MaintenanceWindowResource struct {
+	client *client.Client
+}
+
+// MaintenanceWindowResourceModel describes the resource data model.
+type MaintenanceWindowResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	MonitorIDs   types.List   `tfsdk:"monitor_ids"`
+	StartsAt     types.String `tfsdk:"starts_at"`
+	EndsAt       types.String `tfsdk:"ends_at"`
+	StatusPageID types.Int64  `tfsdk:"status_page_id"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+	BaseURL      types.String `tfsdk:"base_url"`
+}
+
+func (r *MaintenanceWindowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_window"
+}
+
+func (r *MaintenanceWindowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Phare scheduled maintenance window, which suppresses alerting for its monitors and is shown on status pages. Its attributes use the field names of the Phare API, `name`, `starts_at` and `ends_at`, rather than `title`, `start_at` and `end_at`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the maintenance window",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the maintenance window",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the maintenance window",
+				Optional:            true,
+			},
+			"monitor_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the monitors under maintenance",
+				Required:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"starts_at": schema.StringAttribute{
+				MarkdownDescription: "RFC3339 timestamp when the maintenance starts. Changing it once the window is active forces a new resource to be created",
+				Required:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfWindowActive,
+						"Changing starts_at of an active maintenance window forces a new resource to be created.",
+						"Changing `starts_at` of an active maintenance window forces a new resource to be created.",
+					),
+				},
+			},
+			"ends_at": schema.StringAttribute{
+				MarkdownDescription: "RFC3339 timestamp when the maintenance ends, after `starts_at`",
+				Required:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"status_page_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the status page announcing the maintenance. When unset, the maintenance is shown on the status pages displaying its monitors",
+				Optional:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the maintenance window was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the maintenance window was last updated",
+				Computed:            true,
+			},
+			"base_url": baseURLAttribute(),
+		},
+	}
+}
+
+func (r *MaintenanceWindowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data MaintenanceWindowResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.StartsAt.IsNull() || data.StartsAt.IsUnknown() || data.EndsAt.IsNull() || data.EndsAt.IsUnknown() {
+		return
+	}
+
+	// Malformed timestamps are reported by the attribute validators
+	startsAt, err := time.Parse(time.RFC3339, data.StartsAt.ValueString())
+	if err != nil {
+		return
+	}
+	endsAt, err := time.Parse(time.RFC3339, data.EndsAt.ValueString())
+	if err != nil {
+		return
+	}
+
+	if !endsAt.After(startsAt) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ends_at"),
+			"Invalid Maintenance Window",
+			fmt.Sprintf("ends_at (%s) must be after starts_at (%s)", data.EndsAt.ValueString(), data.StartsAt.ValueString()),
+		)
+	}
+}
+
+func (r *MaintenanceWindowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MaintenanceWindowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MaintenanceWindowResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, diags := r.terraformToAPIModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating maintenance window", map[string]any{"name": data.Name.ValueString()})
+
+	api := scopedClient(r.client, data.BaseURL)
+	created, err := api.CreateMaintenanceWindow(ctx, window)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create maintenance window", err.Error())
+		return
+	}
+
+	if created.ID == nil {
+		resp.Diagnostics.AddError("Failed to create maintenance window", "API did not return a maintenance window ID")
+		return
+	}
+
+	// Read back the maintenance window to get all fields
+	fullWindow, err := api.GetMaintenanceWindow(ctx, *created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read created maintenance window", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiToTerraformModel(fullWindow, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MaintenanceWindowResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading maintenance window", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid maintenance window ID", fmt.Sprintf("Failed to parse maintenance window ID: %s", err.Error()))
+		return
+	}
+
+	window, err := scopedClient(r.client, data.BaseURL).GetMaintenanceWindow(ctx, id)
+	if client.IsNotFound(err) {
+		// Deleted outside Terraform, remove it so that it's planned for creation
+		tflog.Warn(ctx, "Maintenance window not found, removing from state", map[string]any{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read maintenance window", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiToTerraformModel(window, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MaintenanceWindowResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, diags := r.terraformToAPIModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating maintenance window", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid maintenance window ID", fmt.Sprintf("Failed to parse maintenance window ID: %s", err.Error()))
+		return
+	}
+
+	updated, err := scopedClient(r.client, data.BaseURL).UpdateMaintenanceWindow(ctx, id, window)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update maintenance window", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiToTerraformModel(updated, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceWindowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MaintenanceWindowResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting maintenance window", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid maintenance window ID", fmt.Sprintf("Failed to parse maintenance window ID: %s", err.Error()))
+		return
+	}
+
+	if err := scopedClient(r.client, data.BaseURL).DeleteMaintenanceWindow(ctx, id); err != nil {
+		resp.Diagnostics.AddError("Failed to delete maintenance window", err.Error())
+		return
+	}
+}
+
+func (r *MaintenanceWindowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// terraformToAPIModel converts Terraform model to API client model
+func (r *MaintenanceWindowResource) terraformToAPIModel(ctx context.Context, data *MaintenanceWindowResourceModel) (*client.MaintenanceWindow, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	window := &client.MaintenanceWindow{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		StartsAt:    data.StartsAt.ValueString(),
+		EndsAt:      data.EndsAt.ValueString(),
+	}
+
+	if !data.StatusPageID.IsNull() {
+		statusPageID := int(data.StatusPageID.ValueInt64())
+		window.StatusPageID = &statusPageID
+	}
+
+	var monitorIDs []int64
+	diags.Append(data.MonitorIDs.ElementsAs(ctx, &monitorIDs, false)...)
+
+	window.MonitorIDs = make([]int, len(monitorIDs))
+	for i, id := range monitorIDs {
+		window.MonitorIDs[i] = int(id)
+	}
+
+	return window, diags
+}
+
+// apiToTerraformModel converts API client model to Terraform model
+func (r *MaintenanceWindowResource) apiToTerraformModel(window *client.MaintenanceWindow, data *MaintenanceWindowResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if window.ID != nil {
+		data.ID = types.StringValue(strconv.Itoa(*window.ID))
+	}
+	data.Name = types.StringValue(window.Name)
+	data.Description = types.StringPointerValue(window.Description)
+	data.StartsAt = sameInstantValue(window.StartsAt, data.StartsAt)
+	data.EndsAt = sameInstantValue(window.EndsAt, data.EndsAt)
+	data.StatusPageID = int64PointerValue(window.StatusPageID)
+	data.CreatedAt = timestampValue(window.CreatedAt, data.CreatedAt)
+	data.UpdatedAt = timestampValue(window.UpdatedAt, data.UpdatedAt)
+
+	monitorIDs := make([]int64, len(window.MonitorIDs))
+	for i, id := range window.MonitorIDs {
+		monitorIDs[i] = int64(id)
+	}
+	monitorList, diagList := types.ListValueFrom(context.Background(), types.Int64Type, monitorIDs)
+	diags.Append(diagList...)
+	data.MonitorIDs = monitorList
+
+	return diags
+}
+
+// requiresReplaceIfWindowActive requires replacing a maintenance window whose
+// starts_at changes while it is active, as the API can't move the start of a
+// maintenance that has already begun
+func requiresReplaceIfWindowActive(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var endsAt types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ends_at"), &endsAt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.RequiresReplace = maintenanceWindowActive(req.StateValue.ValueString(), endsAt.ValueString(), time.Now())
+}
+
+// maintenanceWindowActive reports whether now falls within a maintenance
+// window. Timestamps that can't be parsed count as inactive.
+func maintenanceWindowActive(startsAt, endsAt string, now time.Time) bool {
+	start, err := time.Parse(time.RFC3339, startsAt)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse(time.RFC3339, endsAt)
+	if err != nil {
+		return false
+	}
+
+	return !now.Before(start) && now.Before(end)
+}
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccMaintenanceWindowResource(t *testing.T) {
+	// Schedule the window in the future so that it can be updated in place
+	startsAt := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour)
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMaintenanceWindowResourceConfig(startsAt, startsAt.Add(time.Hour)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_maintenance_window.test",
+						tfjsonpath.New("monitor_ids"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_maintenance_window.test",
+						tfjsonpath.New("starts_at"),
+						knownvalue.StringExact(startsAt.Format(time.RFC3339)),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "phare_maintenance_window.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API may return the timestamps in another format
+				ImportStateVerifyIgnore: []string{"starts_at", "ends_at"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccMaintenanceWindowResourceConfig(startsAt, startsAt.Add(2*time.Hour)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_maintenance_window.test",
+						tfjsonpath.New("ends_at"),
+						knownvalue.StringExact(startsAt.Add(2*time.Hour).Format(time.RFC3339)),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccMaintenanceWindowResourceConfig(startsAt, endsAt time.Time) string {
+	return fmt.Sprintf(`
+resource "phare_uptime_monitor" "maintenance_test" {
+  name     = "TF Test Maintenance"
+  protocol = "http"
+
+  http_request = {
+    method = "GET"
+    url    = "https://immich.app"
+  }
+
+  interval               = 60
+  timeout                = 5000
+  incident_confirmations = 1
+  recovery_confirmations = 1
+  regions                = ["na-usa-iad"]
+}
+
+resource "phare_maintenance_window" "test" {
+  name        = "TF Test Maintenance Window"
+  description = "Created by the provider acceptance tests"
+  monitor_ids = [tonumber(phare_uptime_monitor.maintenance_test.id)]
+  starts_at   = %[1]q
+  ends_at     = %[2]q
+}
+`, startsAt.Format(time.RFC3339), endsAt.Format(time.RFC3339))
+}
+
+func TestMaintenanceWindowResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &MaintenanceWindowResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name      string
+		startsAt  types.String
+		endsAt    types.String
+		wantError bool
+	}{
+		{
+			name:     "ends after start",
+			startsAt: types.StringValue("2024-01-01T10:00:00Z"),
+			endsAt:   types.StringValue("2024-01-01T12:00:00+01:00"),
+		},
+		{
+			name:      "ends before start",
+			startsAt:  types.StringValue("2024-01-01T10:00:00Z"),
+			endsAt:    types.StringValue("2024-01-01T09:00:00Z"),
+			wantError: true,
+		},
+		{
+			name:      "ends at start in another offset",
+			startsAt:  types.StringValue("2024-01-01T10:00:00Z"),
+			endsAt:    types.StringValue("2024-01-01T11:00:00+01:00"),
+			wantError: true,
+		},
+		{
+			name:     "unknown end",
+			startsAt: types.StringValue("2024-01-01T10:00:00Z"),
+			endsAt:   types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			state := tfsdk.State(config)
+			diags := state.Set(ctx, &MaintenanceWindowResourceModel{
+				ID:           types.StringNull(),
+				Name:         types.StringValue("Database upgrade"),
+				Description:  types.StringNull(),
+				MonitorIDs:   types.ListValueMust(types.Int64Type, nil),
+				StartsAt:     tt.startsAt,
+				EndsAt:       tt.endsAt,
+				StatusPageID: types.Int64Null(),
+				CreatedAt:    types.StringNull(),
+				UpdatedAt:    types.StringNull(),
+				BaseURL:      types.StringNull(),
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			config.Raw = state.Raw
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Errorf("HasError() = %v, want %v: %v", got, tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowActive(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		startsAt string
+		endsAt   string
+		want     bool
+	}{
+		{
+			name:     "scheduled",
+			startsAt: "2024-01-02T00:00:00Z",
+			endsAt:   "2024-01-02T02:00:00Z",
+		},
+		{
+			name:     "active",
+			startsAt: "2024-01-01T11:00:00Z",
+			endsAt:   "2024-01-01T13:00:00Z",
+			want:     true,
+		},
+		{
+			name:     "starts now",
+			startsAt: "2024-01-01T12:00:00Z",
+			endsAt:   "2024-01-01T13:00:00Z",
+			want:     true,
+		},
+		{
+			name:     "ended",
+			startsAt: "2024-01-01T10:00:00Z",
+			endsAt:   "2024-01-01T12:00:00Z",
+		},
+		{
+			name:     "invalid timestamp",
+			startsAt: "yesterday",
+			endsAt:   "2024-01-01T13:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maintenanceWindowActive(tt.startsAt, tt.endsAt, now); got != tt.want {
+				t.Errorf("maintenanceWindowActive() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
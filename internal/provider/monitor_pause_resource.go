@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MonitorPauseResource{}
+var _ resource.ResourceWithImportState = &MonitorPauseResource{}
+
+func NewMonitorPauseResource() resource.Resource {
+	return &MonitorPauseResource{}
+}
+
+// MonitorPauseResource keeps a set of monitors paused while it exists and
+// resumes them when it is destroyed.
+type MonitorPauseResource struct {
+	client client.MonitorAPI
+}
+
+// MonitorPauseResourceModel describes the resource data model.
+type MonitorPauseResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	MonitorIDs types.Set    `tfsdk:"monitor_ids"`
+	BaseURL    types.String `tfsdk:"base_url"`
+}
+
+func (r *MonitorPauseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_pause"
+}
+
+func (r *MonitorPauseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Pauses a set of Phare uptime monitors while the resource exists, and resumes them when it is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the pause, the comma-separated IDs of the monitors paused on creation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitor_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the monitors to pause. Monitors resumed outside Terraform are paused again on the next apply",
+				Required:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"base_url": baseURLAttribute(),
+		},
+	}
+}
+
+func (r *MonitorPauseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MonitorPauseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MonitorPauseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := monitorIDsFromSet(ctx, data.MonitorIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Pausing monitors", map[string]any{"monitor_ids": ids})
+
+	paused, errs := setMonitorsPaused(ctx, scopedClient(r.client, data.BaseURL), ids, true)
+
+	// Record the monitors that were paused, even on partial failure, so that
+	// destroying the resource resumes them
+	data.ID = types.StringValue(joinMonitorIDs(ids))
+	data.MonitorIDs = monitorIDsSetValue(paused, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	addMonitorPauseErrors(&resp.Diagnostics, "Failed to pause monitors", errs)
+}
+
+func (r *MonitorPauseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MonitorPauseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := monitorIDsFromSet(ctx, data.MonitorIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading paused monitors", map[string]any{"monitor_ids": ids})
+
+	// Keep the monitors that are still paused, so that monitors resumed or
+	// deleted outside Terraform show up as a diff
+	api := scopedClient(r.client, data.BaseURL)
+
+	var paused []int
+	for _, id := range ids {
+		monitor, err := api.GetMonitor(ctx, id)
+		if client.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read monitor", fmt.Sprintf("Failed to read monitor %d: %s", id, err.Error()))
+			return
+		}
+
+		if monitor.Paused != nil && *monitor.Paused {
+			paused = append(paused, id)
+		}
+	}
+
+	data.MonitorIDs = monitorIDsSetValue(paused, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MonitorPauseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MonitorPauseResourceModel
+	var state MonitorPauseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := monitorIDsFromSet(ctx, data.MonitorIDs, &resp.Diagnostics)
+	current := monitorIDsFromSet(ctx, state.MonitorIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toPause, toResume []int
+	for _, id := range planned {
+		if !slices.Contains(current, id) {
+			toPause = append(toPause, id)
+		}
+	}
+	for _, id := range current {
+		if !slices.Contains(planned, id) {
+			toResume = append(toResume, id)
+		}
+	}
+
+	tflog.Debug(ctx, "Updating paused monitors", map[string]any{"pause": toPause, "resume": toResume})
+
+	api := scopedClient(r.client, data.BaseURL)
+	paused, pauseErrs := setMonitorsPaused(ctx, api, toPause, true)
+	resumed, resumeErrs := setMonitorsPaused(ctx, api, toResume, false)
+
+	// Record the monitors that are paused after the update, even on partial
+	// failure
+	var result []int
+	for _, id := range append(current, paused...) {
+		if !slices.Contains(resumed, id) {
+			result = append(result, id)
+		}
+	}
+
+	data.MonitorIDs = monitorIDsSetValue(result, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	addMonitorPauseErrors(&resp.Diagnostics, "Failed to pause monitors", pauseErrs)
+	addMonitorPauseErrors(&resp.Diagnostics, "Failed to resume monitors", resumeErrs)
+}
+
+func (r *MonitorPauseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MonitorPauseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := monitorIDsFromSet(ctx, data.MonitorIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Resuming monitors", map[string]any{"monitor_ids": ids})
+
+	_, errs := setMonitorsPaused(ctx, scopedClient(r.client, data.BaseURL), ids, false)
+	addMonitorPauseErrors(&resp.Diagnostics, "Failed to resume monitors", errs)
+}
+
+// ImportState imports a pause from the comma-separated IDs of the paused
+// monitors, monitors that aren't paused are dropped by the following read
+func (r *MonitorPauseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var ids []int
+	for _, part := range strings.Split(req.ID, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				fmt.Sprintf("Expected comma-separated monitor IDs such as \"12,34\", got %q", req.ID),
+			)
+			return
+		}
+		ids = append(ids, id)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), joinMonitorIDs(ids))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("monitor_ids"), monitorIDsSetValue(ids, &resp.Diagnostics))...)
+}
+
+// setMonitorsPaused pauses or resumes each monitor. It returns the IDs of the
+// monitors that were updated and an error for each monitor that failed.
+// Monitors that no longer exist count as resumed.
+func setMonitorsPaused(ctx context.Context, api client.MonitorAPI, ids []int, paused bool) ([]int, []error) {
+	var done []int
+	var errs []error
+
+	for _, id := range ids {
+		var err error
+		if paused {
+			err = api.PauseMonitor(ctx, id)
+		} else {
+			err = api.ResumeMonitor(ctx, id)
+			if client.IsNotFound(err) {
+				err = nil
+			}
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("monitor %d: %w", id, err))
+			continue
+		}
+		done = append(done, id)
+	}
+
+	return done, errs
+}
+
+// addMonitorPauseErrors adds a single error listing every failed monitor
+func addMonitorPauseErrors(diags *diag.Diagnostics, summary string, errs []error) {
+	if len(errs) == 0 {
+		return
+	}
+
+	diags.AddError(summary, errors.Join(errs...).Error())
+}
+
+// monitorIDsFromSet returns the sorted monitor IDs of a monitor_ids value
+func monitorIDsFromSet(ctx context.Context, set types.Set, diags *diag.Diagnostics) []int {
+	var values []int64
+	diags.Append(set.ElementsAs(ctx, &values, false)...)
+
+	ids := make([]int, 0, len(values))
+	for _, v := range values {
+		ids = append(ids, int(v))
+	}
+	slices.Sort(ids)
+
+	return ids
+}
+
+// monitorIDsSetValue converts monitor IDs to a monitor_ids value
+func monitorIDsSetValue(ids []int, diags *diag.Diagnostics) types.Set {
+	values := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, int64(id))
+	}
+
+	set, setDiags := types.SetValueFrom(context.Background(), types.Int64Type, values)
+	diags.Append(setDiags...)
+
+	return set
+}
+
+// joinMonitorIDs formats monitor IDs as a sorted comma-separated list
+func joinMonitorIDs(ids []int) string {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+
+	parts := make([]string, 0, len(sorted))
+	for _, id := range sorted {
+		parts = append(parts, strconv.Itoa(id))
+	}
+
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+func TestAccMonitorPauseResource(t *testing.T) {
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccMonitorPauseResourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_monitor_pause.test",
+						tfjsonpath.New("monitor_ids"),
+						knownvalue.SetSizeExact(1),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "phare_monitor_pause.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMonitorPauseResourceConfig() string {
+	return `
+resource "phare_uptime_monitor" "pause_test" {
+  name     = "TF Test Pause"
+  protocol = "http"
+
+  http_request = {
+    method = "GET"
+    url    = "https://immich.app"
+  }
+
+  interval               = 60
+  timeout                = 5000
+  incident_confirmations = 1
+  recovery_confirmations = 1
+  regions                = ["na-usa-iad"]
+
+  lifecycle {
+    ignore_changes = [paused]
+  }
+}
+
+resource "phare_monitor_pause" "test" {
+  monitor_ids = [tonumber(phare_uptime_monitor.pause_test.id)]
+}
+`
+}
+
+func TestMonitorPauseResourceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeMonitorAPI()
+	for range 2 {
+		if _, err := fake.CreateMonitor(ctx, &client.Monitor{Name: "api"}); err != nil {
+			t.Fatalf("failed to create monitor: %v", err)
+		}
+	}
+	r := &MonitorPauseResource{client: fake}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+	emptyState := func() tfsdk.State {
+		return tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+	}
+	stateIDs := func(state tfsdk.State) []int {
+		var data MonitorPauseResourceModel
+		diags := state.Get(ctx, &data)
+		ids := monitorIDsFromSet(ctx, data.MonitorIDs, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return ids
+	}
+
+	// Monitor 3 doesn't exist, the monitors that were paused are recorded
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.Set(ctx, &MonitorPauseResourceModel{
+		ID:         types.StringUnknown(),
+		MonitorIDs: monitorIDsSetValue([]int{1, 2, 3}, &schemaResp.Diagnostics),
+		BaseURL:    types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	createResp := &resource.CreateResponse{State: emptyState()}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected a single error for the missing monitor, got %v", createResp.Diagnostics)
+	}
+	if got := stateIDs(createResp.State); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("monitor_ids after create = %v, want [1 2]", got)
+	}
+
+	// Monitor 2 resumed outside Terraform is dropped on read
+	if err := fake.ResumeMonitor(ctx, 2); err != nil {
+		t.Fatalf("failed to resume monitor: %v", err)
+	}
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", readResp.Diagnostics)
+	}
+	if got := stateIDs(readResp.State); !slices.Equal(got, []int{1}) {
+		t.Errorf("monitor_ids after read = %v, want [1]", got)
+	}
+
+	// Destroying the pause resumes the remaining monitors
+	fake.calls = nil
+	deleteResp := &resource.DeleteResponse{State: emptyState()}
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", deleteResp.Diagnostics)
+	}
+	if want := []string{"ResumeMonitor(1)"}; !slices.Equal(fake.calls, want) {
+		t.Errorf("calls = %v, want %v", fake.calls, want)
+	}
+	if paused := fake.monitors[1].Paused; paused == nil || *paused {
+		t.Error("expected monitor 1 to be resumed")
+	}
+}
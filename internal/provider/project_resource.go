@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectResource{}
+var _ resource.ResourceWithImportState = &ProjectResource{}
+
+func NewProjectResource() resource.Resource {
+	return &ProjectResource{}
+}
+
+// ProjectResource defines the resource implementation.
+type ProjectResource struct {
+	client *client.Client
+}
+
+// ProjectResourceModel describes the resource data model.
+type ProjectResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Slug        types.String `tfsdk:"slug"`
+	Description types.String `tfsdk:"description"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+	BaseURL     types.String `tfsdk:"base_url"`
+}
+
+func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Phare project, used to group monitors, alert rules and other resources through their `project_id`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the project",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the project (2-50 characters)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 50),
+				},
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "URL-friendly identifier of the project, generated by Phare from the name",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the project",
+				Optional:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the project was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the project was last updated",
+				Computed:            true,
+			},
+			"base_url": baseURLAttribute(),
+		},
+	}
+}
+
+func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating project", map[string]any{"name": data.Name.ValueString()})
+
+	api := scopedClient(r.client, data.BaseURL)
+	created, err := api.CreateProject(ctx, r.terraformToAPIModel(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create project", err.Error())
+		return
+	}
+
+	if created.ID == nil {
+		resp.Diagnostics.AddError("Failed to create project", "API did not return a project ID")
+		return
+	}
+
+	// Read back the project to get the generated slug and timestamps
+	project, err := api.GetProject(ctx, *created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read created project", err.Error())
+		return
+	}
+
+	r.apiToTerraformModel(project, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading project", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid project ID", fmt.Sprintf("Failed to parse project ID: %s", err.Error()))
+		return
+	}
+
+	project, err := scopedClient(r.client, data.BaseURL).GetProject(ctx, id)
+	if client.IsNotFound(err) {
+		// Deleted outside Terraform, remove it so that it's planned for creation
+		tflog.Warn(ctx, "Project not found, removing from state", map[string]any{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read project", err.Error())
+		return
+	}
+
+	r.apiToTerraformModel(project, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating project", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid project ID", fmt.Sprintf("Failed to parse project ID: %s", err.Error()))
+		return
+	}
+
+	updated, err := scopedClient(r.client, data.BaseURL).UpdateProject(ctx, id, r.terraformToAPIModel(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update project", err.Error())
+		return
+	}
+
+	r.apiToTerraformModel(updated, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting project", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid project ID", fmt.Sprintf("Failed to parse project ID: %s", err.Error()))
+		return
+	}
+
+	err = scopedClient(r.client, data.BaseURL).DeleteProject(ctx, id)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete project", err.Error())
+		return
+	}
+}
+
+func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// terraformToAPIModel converts Terraform model to API client model
+func (r *ProjectResource) terraformToAPIModel(data *ProjectResourceModel) *client.Project {
+	project := &client.Project{
+		Name: data.Name.ValueString(),
+	}
+
+	if !data.Description.IsNull() {
+		project.Description = stringPtr(data.Description.ValueString())
+	}
+
+	return project
+}
+
+// apiToTerraformModel converts API client model to Terraform model
+func (r *ProjectResource) apiToTerraformModel(project *client.Project, data *ProjectResourceModel) {
+	if project.ID != nil {
+		data.ID = types.StringValue(fmt.Sprintf("%d", *project.ID))
+	}
+	data.Name = types.StringValue(project.Name)
+	data.Slug = types.StringPointerValue(project.Slug)
+
+	// An unset description may be returned as an empty string
+	data.Description = types.StringPointerValue(project.Description)
+	if project.Description != nil && *project.Description == "" {
+		data.Description = types.StringNull()
+	}
+
+	data.CreatedAt = timestampValue(project.CreatedAt, data.CreatedAt)
+	data.UpdatedAt = timestampValue(project.UpdatedAt, data.UpdatedAt)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+func TestAccProjectResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfig("TF Test Project"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_project.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("TF Test Project"),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_project.test",
+						tfjsonpath.New("slug"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "phare_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectResourceConfig("TF Test Project v2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_project.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("TF Test Project v2"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "phare_project" "test" {
+  name        = %[1]q
+  description = "Managed by the Terraform acceptance tests"
+}
+`, name)
+}
+
+func TestProjectResourceEmptyDescription(t *testing.T) {
+	r := &ProjectResource{}
+
+	id := 1
+	data := ProjectResourceModel{Description: types.StringNull()}
+	r.apiToTerraformModel(&client.Project{
+		ID:          &id,
+		Name:        "Platform",
+		Slug:        stringPtr("platform"),
+		Description: stringPtr(""),
+	}, &data)
+
+	if !data.Description.IsNull() {
+		t.Errorf("description = %s, want null", data.Description)
+	}
+	if got := data.ID.ValueString(); got != "1" {
+		t.Errorf("id = %q, want %q", got, "1")
+	}
+	if got := data.Slug.ValueString(); got != "platform" {
+		t.Errorf("slug = %q, want %q", got, "platform")
+	}
+}
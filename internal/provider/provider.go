@@ -5,15 +5,25 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"regexp"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/phare/terraform-provider-phare/internal/client"
 )
@@ -23,6 +33,13 @@ var _ provider.Provider = &PhareProvider{}
 var _ provider.ProviderWithFunctions = &PhareProvider{}
 var _ provider.ProviderWithEphemeralResources = &PhareProvider{}
 
+// mediaTypeRegexp matches a media type, optionally followed by parameters.
+var mediaTypeRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]*/[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]*(\s*;.*)?$`)
+
+// timeoutEnvVars lists the environment variables setting the request timeout,
+// by precedence. PHARE_REQUEST_TIMEOUT is an alias of PHARE_TIMEOUT.
+var timeoutEnvVars = []string{"PHARE_TIMEOUT", "PHARE_REQUEST_TIMEOUT"}
+
 // PhareProvider defines the provider implementation.
 type PhareProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -33,8 +50,19 @@ type PhareProvider struct {
 
 // PhareProviderModel describes the provider data model.
 type PhareProviderModel struct {
-	APIToken types.String `tfsdk:"api_token"`
-	BaseURL  types.String `tfsdk:"base_url"`
+	APIToken           types.String `tfsdk:"api_token"`
+	BaseURL            types.String `tfsdk:"base_url"`
+	CredentialsFile    types.String `tfsdk:"credentials_file"`
+	StrictErrorParsing types.Bool   `tfsdk:"strict_error_parsing"`
+	MaxRegions         types.Int64  `tfsdk:"max_regions"`
+	VerifyDelete       types.Bool   `tfsdk:"verify_delete"`
+	LogChanges         types.Bool   `tfsdk:"log_changes"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	RetryDelay         types.Int64  `tfsdk:"retry_delay"`
+	RetryOnStatus      types.List   `tfsdk:"retry_on_status"`
+	Timeout            types.Int64  `tfsdk:"timeout"`
+	AcceptMediaType    types.String `tfsdk:"accept_media_type"`
+	DefaultColors      types.Object `tfsdk:"default_colors"`
 }
 
 func (p *PhareProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -55,6 +83,126 @@ func (p *PhareProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				MarkdownDescription: "Phare API base URL. Defaults to https://api.phare.io. Can also be set via PHARE_BASE_URL environment variable.",
 				Optional:            true,
 			},
+			"credentials_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a JSON file containing `api_token` and optionally `base_url`. " +
+					"Can also be set via PHARE_CREDENTIALS_FILE environment variable. " +
+					"Values set in the provider configuration take precedence, followed by environment variables, then the credentials file.",
+				Optional: true,
+			},
+			"max_regions": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of regions a monitor may use on your Phare plan. " +
+					"Monitors exceeding it fail with a clear error instead of a generic API validation failure. Defaults to `6`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, client.DefaultMaxRegions),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Number of times a request failing with a status listed in `retry_on_status` is retried, with exponential backoff. " +
+					"Set to `0` to disable retries. Defaults to `3`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"retry_delay": schema.Int64Attribute{
+				MarkdownDescription: "Initial wait in milliseconds between retries of a failed request, doubled on each attempt with jitter. " +
+					"A `Retry-After` response header takes precedence. Defaults to `500`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 30000),
+				},
+			},
+			"retry_on_status": schema.ListAttribute{
+				MarkdownDescription: "HTTP status codes of the responses retried. `429` responses are retried for every request, " +
+					"other statuses only for reads, updates and deletes so that creates aren't applied twice. Defaults to `[429, 502, 503, 504]`.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout of each API request in seconds, raise it when uploading large status page logos or behind slow proxies. " +
+					"Defaults to `30`. Can also be set via PHARE_TIMEOUT environment variable, or its alias PHARE_REQUEST_TIMEOUT.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"verify_delete": schema.BoolAttribute{
+				MarkdownDescription: "After deleting a monitor, poll the API until it reports the monitor as not found before completing the delete. " +
+					"The wait is bounded by the `timeouts.delete` of the monitor, `5m` by default. " +
+					"Guarantees dependent teardown steps don't see the deleted monitor. Defaults to `false`.",
+				Optional: true,
+			},
+			"log_changes": schema.BoolAttribute{
+				MarkdownDescription: "Log the names of the attributes changed by each update of a monitor, status page or alert rule at `INFO` level. " +
+					"Values are never logged. Defaults to `false`.",
+				Optional: true,
+			},
+			"accept_media_type": schema.StringAttribute{
+				MarkdownDescription: "Media type sent in the `Accept` header of API requests, such as `application/vnd.phare.v1+json` to pin a response version. " +
+					"Defaults to `application/json`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(mediaTypeRegexp, "must be a media type such as application/json"),
+				},
+			},
+			"default_colors": schema.SingleNestedAttribute{
+				MarkdownDescription: "Colors of the status pages that don't configure `colors`, so that pages share a color scheme. " +
+					"Unset colors keep the built-in defaults.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"operational": schema.StringAttribute{
+						MarkdownDescription: "Color for operational status (hex color code)",
+						Optional:            true,
+						Validators: []validator.String{
+							hexColorValidator{},
+						},
+					},
+					"degraded_performance": schema.StringAttribute{
+						MarkdownDescription: "Color for degraded performance status (hex color code)",
+						Optional:            true,
+						Validators: []validator.String{
+							hexColorValidator{},
+						},
+					},
+					"partial_outage": schema.StringAttribute{
+						MarkdownDescription: "Color for partial outage status (hex color code)",
+						Optional:            true,
+						Validators: []validator.String{
+							hexColorValidator{},
+						},
+					},
+					"major_outage": schema.StringAttribute{
+						MarkdownDescription: "Color for major outage status (hex color code)",
+						Optional:            true,
+						Validators: []validator.String{
+							hexColorValidator{},
+						},
+					},
+					"maintenance": schema.StringAttribute{
+						MarkdownDescription: "Color for maintenance status (hex color code)",
+						Optional:            true,
+						Validators: []validator.String{
+							hexColorValidator{},
+						},
+					},
+					"empty": schema.StringAttribute{
+						MarkdownDescription: "Color for empty/unknown status (hex color code)",
+						Optional:            true,
+						Validators: []validator.String{
+							hexColorValidator{},
+						},
+					},
+				},
+			},
+			"strict_error_parsing": schema.BoolAttribute{
+				MarkdownDescription: "Fail with the full raw response body whenever an API error response doesn't match the standard error shape. " +
+					"Useful in CI to detect API contract changes. Defaults to `false`.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -68,8 +216,32 @@ func (p *PhareProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	// Get API token from config or environment variable
-	apiToken := os.Getenv("PHARE_API_TOKEN")
+	// Load the credentials file first, it has the lowest precedence
+	credentialsPath := os.Getenv("PHARE_CREDENTIALS_FILE")
+	if !data.CredentialsFile.IsNull() {
+		credentialsPath = data.CredentialsFile.ValueString()
+	}
+
+	var creds credentialsFile
+	if credentialsPath != "" {
+		loaded, err := loadCredentialsFile(credentialsPath)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("credentials_file"),
+				"Invalid Credentials File",
+				"The Phare credentials file could not be loaded. "+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+		creds = *loaded
+	}
+
+	// Get API token from config, environment variable or credentials file
+	apiToken := creds.APIToken
+	if envToken := os.Getenv("PHARE_API_TOKEN"); envToken != "" {
+		apiToken = envToken
+	}
 	if !data.APIToken.IsNull() {
 		apiToken = data.APIToken.ValueString()
 	}
@@ -77,14 +249,17 @@ func (p *PhareProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	if apiToken == "" {
 		resp.Diagnostics.AddError(
 			"Missing API Token Configuration",
-			"API token must be configured either via the provider configuration "+
-				"or the PHARE_API_TOKEN environment variable.",
+			"API token must be configured either via the provider configuration, "+
+				"the PHARE_API_TOKEN environment variable or a credentials file.",
 		)
 		return
 	}
 
-	// Get base URL from config or environment variable, default to production
+	// Get base URL from config, environment variable or credentials file, default to production
 	baseURL := client.DefaultBaseURL
+	if creds.BaseURL != "" {
+		baseURL = creds.BaseURL
+	}
 	if envURL := os.Getenv("PHARE_BASE_URL"); envURL != "" {
 		baseURL = envURL
 	}
@@ -92,12 +267,71 @@ func (p *PhareProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		baseURL = data.BaseURL.ValueString()
 	}
 
+	// Get the request timeout from config or environment variable
+	timeout, timeoutEnvVar, err := envRequestTimeout()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			fmt.Sprintf("Invalid %s Environment Variable", timeoutEnvVar),
+			err.Error(),
+		)
+		return
+	}
+	if !data.Timeout.IsNull() {
+		timeout = time.Duration(data.Timeout.ValueInt64()) * time.Second
+	}
+
 	tflog.Debug(ctx, "Configuring Phare API client", map[string]any{
 		"base_url": baseURL,
 	})
 
+	opts := []client.Option{
+		client.WithStrictErrorParsing(data.StrictErrorParsing.ValueBool()),
+		client.WithMaxRegions(int(data.MaxRegions.ValueInt64())),
+		client.WithVerifyDelete(data.VerifyDelete.ValueBool()),
+		client.WithLogChanges(data.LogChanges.ValueBool()),
+		client.WithAcceptMediaType(data.AcceptMediaType.ValueString()),
+		client.WithTimeout(timeout),
+		client.WithUserAgent(fmt.Sprintf("%s/%s (terraform-plugin-framework)", client.DefaultUserAgent, p.version)),
+	}
+	if !data.DefaultColors.IsNull() {
+		var colors StatusPageColorsModel
+		resp.Diagnostics.Append(data.DefaultColors.As(ctx, &colors, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		opts = append(opts, client.WithDefaultColors(client.StatusPageColors{
+			Operational:         colors.Operational.ValueString(),
+			DegradedPerformance: colors.DegradedPerformance.ValueString(),
+			PartialOutage:       colors.PartialOutage.ValueString(),
+			MajorOutage:         colors.MajorOutage.ValueString(),
+			Maintenance:         colors.Maintenance.ValueString(),
+			Empty:               colors.Empty.ValueString(),
+		}))
+	}
+	if !data.MaxRetries.IsNull() {
+		opts = append(opts, client.WithMaxRetries(int(data.MaxRetries.ValueInt64())))
+	}
+	if !data.RetryDelay.IsNull() {
+		opts = append(opts, client.WithRetryDelay(time.Duration(data.RetryDelay.ValueInt64())*time.Millisecond))
+	}
+	if !data.RetryOnStatus.IsNull() {
+		var codes []int64
+		resp.Diagnostics.Append(data.RetryOnStatus.ElementsAs(ctx, &codes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		retryOn := make([]int, 0, len(codes))
+		for _, code := range codes {
+			retryOn = append(retryOn, int(code))
+		}
+		opts = append(opts, client.WithRetryOnStatus(retryOn))
+	}
+
 	// Create the Phare API client
-	phareClient, err := client.NewClient(apiToken, baseURL)
+	phareClient, err := client.NewClient(apiToken, baseURL, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Phare API Client",
@@ -117,6 +351,13 @@ func (p *PhareProvider) Resources(ctx context.Context) []func() resource.Resourc
 		NewUptimeMonitorResource,
 		NewAlertRuleResource,
 		NewStatusPageResource,
+		NewEscalationPolicyResource,
+		NewMonitorPauseResource,
+		NewUptimeIncidentResource,
+		NewMaintenanceWindowResource,
+		NewStatusPageIncidentResource,
+		NewIntegrationResource,
+		NewProjectResource,
 	}
 }
 
@@ -129,12 +370,22 @@ func (p *PhareProvider) EphemeralResources(ctx context.Context) []func() ephemer
 func (p *PhareProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewUptimeIncidentDataSource,
+		NewUptimeIncidentsDataSource,
+		NewIntegrationsDataSource,
+		NewIntegrationDataSource,
+		NewUptimeMonitorOverviewDataSource,
+		NewStatusPagesByMonitorDataSource,
+		NewUptimeMonitorDataSource,
+		NewUptimeMonitorsDataSource,
+		NewStatusPageDataSource,
+		NewStatusPagesDataSource,
+		NewAlertRulesDataSource,
 	}
 }
 
 func (p *PhareProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
-		// Phare doesn't use functions
+		NewReplaceRegionFunction,
 	}
 }
 
@@ -145,3 +396,23 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// envRequestTimeout returns the request timeout set by the first of
+// timeoutEnvVars that is set, along with the name of that variable. It
+// returns 0 when none is set.
+func envRequestTimeout() (time.Duration, string, error) {
+	for _, name := range timeoutEnvVars {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds < 1 {
+			return 0, name, fmt.Errorf("%s must be a positive number of seconds, got %q", name, value)
+		}
+		return time.Duration(seconds) * time.Second, name, nil
+	}
+
+	return 0, "", nil
+}
@@ -6,6 +6,7 @@ package provider
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -35,3 +36,58 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("PHARE_API_TOKEN must be set for acceptance tests")
 	}
 }
+
+func TestEnvRequestTimeout(t *testing.T) {
+	tests := []struct {
+		name       string
+		timeout    string
+		alias      string
+		want       time.Duration
+		wantEnvVar string
+		wantError  bool
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name:       "PHARE_TIMEOUT",
+			timeout:    "60",
+			want:       60 * time.Second,
+			wantEnvVar: "PHARE_TIMEOUT",
+		},
+		{
+			name:       "PHARE_REQUEST_TIMEOUT alias",
+			alias:      "45",
+			want:       45 * time.Second,
+			wantEnvVar: "PHARE_REQUEST_TIMEOUT",
+		},
+		{
+			name:       "PHARE_TIMEOUT takes precedence over the alias",
+			timeout:    "60",
+			alias:      "45",
+			want:       60 * time.Second,
+			wantEnvVar: "PHARE_TIMEOUT",
+		},
+		{
+			name:       "invalid alias",
+			alias:      "soon",
+			wantEnvVar: "PHARE_REQUEST_TIMEOUT",
+			wantError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("PHARE_TIMEOUT", tt.timeout)
+			t.Setenv("PHARE_REQUEST_TIMEOUT", tt.alias)
+
+			got, envVar, err := envRequestTimeout()
+			if (err != nil) != tt.wantError {
+				t.Fatalf("envRequestTimeout() error = %v, wantError %v", err, tt.wantError)
+			}
+			if got != tt.want || envVar != tt.wantEnvVar {
+				t.Errorf("envRequestTimeout() = %s, %q, want %s, %q", got, envVar, tt.want, tt.wantEnvVar)
+			}
+		})
+	}
+}
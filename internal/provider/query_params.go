@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// appendQueryParams encodes params and appends them to the query string of
+// rawURL, leaving the existing query string untouched
+func appendQueryParams(rawURL string, params map[string]string) (string, error) {
+	if len(params) == 0 {
+		return rawURL, nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse url: %w", err)
+	}
+
+	values := url.Values{}
+	for k, v := range params {
+		values.Set(k, v)
+	}
+
+	if u.RawQuery == "" {
+		u.RawQuery = values.Encode()
+	} else {
+		u.RawQuery += "&" + values.Encode()
+	}
+
+	return u.String(), nil
+}
+
+// splitQueryParams removes the query parameters named in keys from rawURL,
+// returning the remaining URL and the values of the removed parameters.
+// Parameters that are not in keys keep their original encoding and order.
+func splitQueryParams(rawURL string, keys []string) (string, map[string]string) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.RawQuery == "" || len(keys) == 0 {
+		return rawURL, map[string]string{}
+	}
+
+	wanted := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		wanted[k] = true
+	}
+
+	params := map[string]string{}
+	var kept []string
+	for _, pair := range strings.Split(u.RawQuery, "&") {
+		rawKey, rawValue, _ := strings.Cut(pair, "=")
+		key, keyErr := url.QueryUnescape(rawKey)
+		value, valueErr := url.QueryUnescape(rawValue)
+		if keyErr != nil || valueErr != nil || !wanted[key] {
+			kept = append(kept, pair)
+			continue
+		}
+		params[key] = value
+	}
+
+	u.RawQuery = strings.Join(kept, "&")
+	return u.String(), params
+}
+
+var _ validator.Object = queryParamsValidator{}
+
+// queryParamsValidator checks that http_request query_params don't repeat a
+// parameter that is already present in the literal url.
+type queryParamsValidator struct{}
+
+func (v queryParamsValidator) Description(ctx context.Context) string {
+	return "query_params must not contain parameters already present in url"
+}
+
+func (v queryParamsValidator) MarkdownDescription(ctx context.Context) string {
+	return "`query_params` must not contain parameters already present in `url`"
+}
+
+func (v queryParamsValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attrs := req.ConfigValue.Attributes()
+	rawURL, ok := attrs["url"].(basetypes.StringValue)
+	if !ok || rawURL.IsNull() || rawURL.IsUnknown() {
+		return
+	}
+	params, ok := attrs["query_params"].(basetypes.MapValue)
+	if !ok || params.IsNull() || params.IsUnknown() {
+		return
+	}
+
+	u, err := url.Parse(rawURL.ValueString())
+	if err != nil {
+		return
+	}
+	existing := u.Query()
+
+	var duplicates []string
+	for key := range params.Elements() {
+		if existing.Has(key) {
+			duplicates = append(duplicates, key)
+		}
+	}
+	if len(duplicates) == 0 {
+		return
+	}
+	sort.Strings(duplicates)
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path.AtName("query_params"),
+		"Duplicate Query Parameter",
+		fmt.Sprintf("query_params contains %s, which is already present in url. Set each parameter in only one place.", strings.Join(duplicates, ", ")),
+	)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestQueryParamsRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		params  map[string]string
+		wantURL string
+	}{
+		{
+			name:    "url without query",
+			url:     "https://example.com/health",
+			params:  map[string]string{"region": "eu west", "b": "1"},
+			wantURL: "https://example.com/health?b=1&region=eu+west",
+		},
+		{
+			name:    "url with existing query",
+			url:     "https://example.com/health?token=a%2Fb",
+			params:  map[string]string{"verbose": "true"},
+			wantURL: "https://example.com/health?token=a%2Fb&verbose=true",
+		},
+		{
+			name:    "no params",
+			url:     "https://example.com/health?token=abc",
+			wantURL: "https://example.com/health?token=abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := appendQueryParams(tt.url, tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.wantURL {
+				t.Errorf("appendQueryParams() = %q, want %q", got, tt.wantURL)
+			}
+
+			keys := make([]string, 0, len(tt.params))
+			for k := range tt.params {
+				keys = append(keys, k)
+			}
+			url, params := splitQueryParams(got, keys)
+			if url != tt.url {
+				t.Errorf("splitQueryParams() url = %q, want %q", url, tt.url)
+			}
+			if len(params) != len(tt.params) {
+				t.Fatalf("splitQueryParams() params = %v, want %v", params, tt.params)
+			}
+			for k, v := range tt.params {
+				if params[k] != v {
+					t.Errorf("splitQueryParams() params[%q] = %q, want %q", k, params[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestQueryParamsValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		url       string
+		params    types.Map
+		wantError bool
+	}{
+		{
+			name:   "distinct params",
+			url:    "https://example.com/?a=1",
+			params: types.MapValueMust(types.StringType, map[string]attr.Value{"b": types.StringValue("2")}),
+		},
+		{
+			name:      "param repeated in url",
+			url:       "https://example.com/?a=1",
+			params:    types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("2")}),
+			wantError: true,
+		},
+		{
+			name:   "no params",
+			url:    "https://example.com/?a=1",
+			params: types.MapNull(types.StringType),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := map[string]attr.Value{}
+			for name, attrType := range httpRequestAttrTypes() {
+				attrs[name] = nullValueOf(t, attrType)
+			}
+			attrs["method"] = types.StringValue("GET")
+			attrs["url"] = types.StringValue(tt.url)
+			attrs["query_params"] = tt.params
+
+			req := validator.ObjectRequest{
+				Path:        path.Root("http_request"),
+				ConfigValue: types.ObjectValueMust(httpRequestAttrTypes(), attrs),
+			}
+			resp := &validator.ObjectResponse{}
+
+			queryParamsValidator{}.ValidateObject(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ValidateObject() has error = %v, want %v: %v", resp.Diagnostics.HasError(), tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func nullValueOf(t *testing.T, attrType attr.Type) attr.Value {
+	t.Helper()
+
+	switch typ := attrType.(type) {
+	case types.ListType:
+		return types.ListNull(typ.ElemType)
+	case types.SetType:
+		return types.SetNull(typ.ElemType)
+	case types.MapType:
+		return types.MapNull(typ.ElemType)
+	case types.ObjectType:
+		return types.ObjectNull(typ.AttrTypes)
+	}
+
+	switch attrType {
+	case types.StringType:
+		return types.StringNull()
+	case types.BoolType:
+		return types.BoolNull()
+	case types.Int64Type:
+		return types.Int64Null()
+	}
+
+	t.Fatalf("unsupported attribute type %s", attrType)
+	return nil
+}
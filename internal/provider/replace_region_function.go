@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ReplaceRegionFunction{}
+
+func NewReplaceRegionFunction() function.Function {
+	return &ReplaceRegionFunction{}
+}
+
+// ReplaceRegionFunction defines the replace_region function implementation.
+type ReplaceRegionFunction struct{}
+
+func (f *ReplaceRegionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "replace_region"
+}
+
+func (f *ReplaceRegionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Replace a monitoring region",
+		MarkdownDescription: "Returns `regions` with `old` replaced by `new`, keeping the order of the other regions. " +
+			"If `new` is already present, `old` is removed instead so the result has no duplicates. " +
+			"Regions are compared case-insensitively and returned lowercase.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "regions",
+				MarkdownDescription: "Regions of an uptime monitor",
+				ElementType:         types.StringType,
+			},
+			function.StringParameter{
+				Name:                "old",
+				MarkdownDescription: "Region to replace",
+			},
+			function.StringParameter{
+				Name:                "new",
+				MarkdownDescription: "Region to use instead, must be a known Phare region",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *ReplaceRegionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var regions []string
+	var oldRegion, newRegion string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &regions, &oldRegion, &newRegion))
+	if resp.Error != nil {
+		return
+	}
+
+	newRegion = strings.ToLower(newRegion)
+	if !slices.Contains(monitorRegions, newRegion) {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("%q is not a known region, expected one of: %s", newRegion, strings.Join(monitorRegions, ", ")))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, replaceRegion(regions, oldRegion, newRegion)))
+}
+
+// replaceRegion returns the lowercase regions with old replaced by new,
+// dropping duplicates
+func replaceRegion(regions []string, oldRegion, newRegion string) []string {
+	result := make([]string, 0, len(regions))
+	for _, region := range regions {
+		region = strings.ToLower(region)
+		if strings.EqualFold(region, oldRegion) {
+			region = newRegion
+		}
+		if !slices.Contains(result, region) {
+			result = append(result, region)
+		}
+	}
+	return result
+}
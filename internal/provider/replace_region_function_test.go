@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReplaceRegionFunction(t *testing.T) {
+	tests := []struct {
+		name      string
+		regions   []string
+		old       string
+		new       string
+		want      []string
+		wantError bool
+	}{
+		{
+			name:    "replace region keeping order",
+			regions: []string{"na-usa-iad", "eu-deu-fra", "as-sgp-sin"},
+			old:     "eu-deu-fra",
+			new:     "eu-gbr-lhr",
+			want:    []string{"na-usa-iad", "eu-gbr-lhr", "as-sgp-sin"},
+		},
+		{
+			name:    "new region already present",
+			regions: []string{"na-usa-iad", "eu-deu-fra"},
+			old:     "eu-deu-fra",
+			new:     "na-usa-iad",
+			want:    []string{"na-usa-iad"},
+		},
+		{
+			name:    "old region absent",
+			regions: []string{"NA-USA-IAD"},
+			old:     "eu-deu-fra",
+			new:     "eu-gbr-lhr",
+			want:    []string{"na-usa-iad"},
+		},
+		{
+			name:      "unknown new region",
+			regions:   []string{"na-usa-iad"},
+			old:       "na-usa-iad",
+			new:       "mars-base-1",
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					stringListValue(t, tt.regions),
+					types.StringValue(tt.old),
+					types.StringValue(tt.new),
+				}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.ListUnknown(types.StringType)),
+			}
+
+			(&ReplaceRegionFunction{}).Run(ctx, req, resp)
+
+			if tt.wantError {
+				if resp.Error == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			if got := resp.Result.Value(); !got.Equal(stringListValue(t, tt.want)) {
+				t.Errorf("replace_region() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRFC3339Validator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{
+			name:  "UTC",
+			value: types.StringValue("2024-01-01T12:00:00Z"),
+		},
+		{
+			name:  "offset with fraction",
+			value: types.StringValue("2024-01-01T12:00:00.5+02:00"),
+		},
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:  "unknown",
+			value: types.StringUnknown(),
+		},
+		{
+			name:      "date only",
+			value:     types.StringValue("2024-01-01"),
+			wantError: true,
+		},
+		{
+			name:      "missing offset",
+			value:     types.StringValue("2024-01-01T12:00:00"),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("incident_at"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+
+			rfc3339Validator{}.ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Errorf("HasError() = %v, want %v: %v", got, tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
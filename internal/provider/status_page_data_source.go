@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StatusPageDataSource{}
+
+func NewStatusPageDataSource() datasource.DataSource {
+	return &StatusPageDataSource{}
+}
+
+// StatusPageDataSource defines the data source implementation. It shares
+// the data model and API conversion of StatusPageResource.
+type StatusPageDataSource struct {
+	client *client.Client
+}
+
+func (d *StatusPageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page"
+}
+
+func (d *StatusPageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a Phare status page by ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the status page",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Internal name of the status page",
+				Computed:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Public title displayed on the status page",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description shown on the status page",
+				Computed:            true,
+			},
+			"search_engine_indexed": schema.BoolAttribute{
+				MarkdownDescription: "Whether search engines index the status page",
+				Computed:            true,
+			},
+			"website_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the website the status page is for",
+				Computed:            true,
+			},
+			"subdomain": schema.StringAttribute{
+				MarkdownDescription: "Subdomain of the status page",
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Custom domain of the status page",
+				Computed:            true,
+			},
+			"public_url": schema.StringAttribute{
+				MarkdownDescription: "Public URL of the status page, on its custom domain when set",
+				Computed:            true,
+			},
+			"timeframe": schema.Int64Attribute{
+				MarkdownDescription: "Number of days of history displayed",
+				Computed:            true,
+			},
+			"colors": schema.SingleNestedAttribute{
+				MarkdownDescription: "Color scheme for different status states",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"operational": schema.StringAttribute{
+						MarkdownDescription: "Color for operational status",
+						Computed:            true,
+					},
+					"degraded_performance": schema.StringAttribute{
+						MarkdownDescription: "Color for degraded performance status",
+						Computed:            true,
+					},
+					"partial_outage": schema.StringAttribute{
+						MarkdownDescription: "Color for partial outage status",
+						Computed:            true,
+					},
+					"major_outage": schema.StringAttribute{
+						MarkdownDescription: "Color for major outage status",
+						Computed:            true,
+					},
+					"maintenance": schema.StringAttribute{
+						MarkdownDescription: "Color for maintenance status",
+						Computed:            true,
+					},
+					"empty": schema.StringAttribute{
+						MarkdownDescription: "Color for empty/unknown status",
+						Computed:            true,
+					},
+				},
+			},
+			"components": schema.ListNestedAttribute{
+				MarkdownDescription: "Monitors displayed as components on the status page",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"componentable_type": schema.StringAttribute{
+							MarkdownDescription: "Type of component",
+							Computed:            true,
+						},
+						"componentable_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the monitor displayed",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"component_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of components displayed on the status page",
+				Computed:            true,
+			},
+			"validate_components_exist": schema.BoolAttribute{
+				MarkdownDescription: "Always `false`, only meaningful for the `phare_status_page` resource",
+				Computed:            true,
+			},
+			"warn_on_duplicate_component_names": schema.BoolAttribute{
+				MarkdownDescription: "Always `false`, only meaningful for the `phare_status_page` resource",
+				Computed:            true,
+			},
+			"logo": schema.StringAttribute{
+				MarkdownDescription: "Logo file path or URL",
+				Computed:            true,
+			},
+			"favicon": schema.StringAttribute{
+				MarkdownDescription: "Favicon file path or URL",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the status page was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the status page was last updated",
+				Computed:            true,
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Phare API base URL to read the status page from, overriding the provider `base_url`",
+				Optional:            true,
+				Validators: []validator.String{
+					baseURLValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (d *StatusPageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *StatusPageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StatusPageResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading status page", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid status page ID", fmt.Sprintf("Failed to parse status page ID: %s", err.Error()))
+		return
+	}
+
+	page, err := scopedClient(d.client, data.BaseURL).GetStatusPage(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read status page", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append((&StatusPageResource{}).apiToTerraformModel(ctx, page, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
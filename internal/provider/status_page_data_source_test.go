@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+func TestAccStatusPageDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccStatusPageDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.phare_status_page.test", "name",
+						"phare_status_page.test", "name",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.phare_status_page.test", "colors.operational",
+						"phare_status_page.test", "colors.operational",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.phare_status_page.test", "components.0.componentable_id",
+						"phare_status_page.test", "components.0.componentable_id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccStatusPageDataSourceConfig() string {
+	return testAccStatusPageResourceConfig("Test Status Page Lookup", "Test Status") + `
+data "phare_status_page" "test" {
+  id = phare_status_page.test.id
+}
+`
+}
+
+func TestStatusPageDataSourceStateMatchesSchema(t *testing.T) {
+	ctx := context.Background()
+	d := &StatusPageDataSource{}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	id, timeframe := 1, 90
+	page := &client.StatusPage{
+		ID:          &id,
+		Name:        "public",
+		Title:       "Service Status",
+		Description: "Current status of our services",
+		WebsiteURL:  "https://example.com",
+		Subdomain:   stringPtr("status-example"),
+		Timeframe:   &timeframe,
+		Components: []client.StatusComponent{
+			{ComponentableType: "uptime/monitor", ComponentableID: 2},
+		},
+	}
+
+	var data StatusPageResourceModel
+	diags := (&StatusPageResource{}).apiToTerraformModel(ctx, page, &data)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags.Append(state.Set(ctx, &data)...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := data.ComponentCount.ValueInt64(); got != 1 {
+		t.Errorf("component_count = %d, want 1", got)
+	}
+}
@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StatusPageIncidentResource{}
+var _ resource.ResourceWithImportState = &StatusPageIncidentResource{}
+
+// statusPageIncidentImpacts lists the impacts of a status page incident,
+// maintenance is announced with a maintenance window instead
+var statusPageIncidentImpacts = []string{"degradedPerformance", "partialOutage", "majorOutage"}
+
+func NewStatusPageIncidentResource() resource.Resource {
+	return &StatusPageIncidentResource{}
+}
+
+// StatusPageIncidentResource defines the resource implementation.
+type StatusPageIncidentResource struct {
+	client *client.Client
+}
+
+// StatusPageIncidentResourceModel describes the resource data model.
+type StatusPageIncidentResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	StatusPageID types.Int64  `tfsdk:"status_page_id"`
+	IncidentID   types.Int64  `tfsdk:"incident_id"`
+	Title        types.String `tfsdk:"title"`
+	Body         types.String `tfsdk:"body"`
+	Impact       types.String `tfsdk:"impact"`
+	PublishedAt  types.String `tfsdk:"published_at"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+	BaseURL      types.String `tfsdk:"base_url"`
+}
+
+func (r *StatusPageIncidentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page_incident"
+}
+
+func (r *StatusPageIncidentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an incident announcement posted on a Phare status page, optionally linked to an uptime incident.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the status page incident",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_page_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the status page the incident is posted on. Changing this forces a new resource to be created",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"incident_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the uptime incident the announcement is about",
+				Optional:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the announcement",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "Markdown text of the announcement",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"impact": schema.StringAttribute{
+				MarkdownDescription: "Impact shown on the status page: `degradedPerformance`, `partialOutage` or `majorOutage`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(statusPageIncidentImpacts...),
+				},
+			},
+			"published_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the announcement was published on the status page",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the status page incident was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the status page incident was last updated",
+				Computed:            true,
+			},
+			"base_url": baseURLAttribute(),
+		},
+	}
+}
+
+func (r *StatusPageIncidentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *StatusPageIncidentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StatusPageIncidentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pageID := int(data.StatusPageID.ValueInt64())
+	tflog.Debug(ctx, "Creating status page incident", map[string]any{"status_page_id": pageID, "title": data.Title.ValueString()})
+
+	api := scopedClient(r.client, data.BaseURL)
+	created, err := api.CreateStatusPageIncident(ctx, pageID, r.terraformToAPIModel(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create status page incident", err.Error())
+		return
+	}
+
+	if created.ID == nil {
+		resp.Diagnostics.AddError("Failed to create status page incident", "API did not return a status page incident ID")
+		return
+	}
+
+	// Read back the status page incident to get all fields
+	fullIncident, err := api.GetStatusPageIncident(ctx, pageID, *created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read created status page incident", err.Error())
+		return
+	}
+
+	r.apiToTerraformModel(fullIncident, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageIncidentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StatusPageIncidentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading status page incident", map[string]any{"id": data.ID.ValueString()})
+
+	pageID, id, diags := statusPageIncidentIDs(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	incident, err := scopedClient(r.client, data.BaseURL).GetStatusPageIncident(ctx, pageID, id)
+	if client.IsNotFound(err) {
+		// Deleted outside Terraform, remove it so that it's planned for creation
+		tflog.Warn(ctx, "Status page incident not found, removing from state", map[string]any{"status_page_id": pageID, "id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read status page incident", err.Error())
+		return
+	}
+
+	r.apiToTerraformModel(incident, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageIncidentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StatusPageIncidentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating status page incident", map[string]any{"id": data.ID.ValueString()})
+
+	pageID, id, diags := statusPageIncidentIDs(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := scopedClient(r.client, data.BaseURL).UpdateStatusPageIncident(ctx, pageID, id, r.terraformToAPIModel(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update status page incident", err.Error())
+		return
+	}
+
+	r.apiToTerraformModel(updated, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageIncidentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StatusPageIncidentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting status page incident", map[string]any{"id": data.ID.ValueString()})
+
+	pageID, id, diags := statusPageIncidentIDs(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := scopedClient(r.client, data.BaseURL).DeleteStatusPageIncident(ctx, pageID, id); err != nil {
+		resp.Diagnostics.AddError("Failed to delete status page incident", err.Error())
+		return
+	}
+}
+
+// ImportState imports a status page incident from a `page_id/incident_id` ID,
+// as incidents are only addressable through their status page
+func (r *StatusPageIncidentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	pageID, id, err := parseStatusPageIncidentImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form page_id/incident_id, e.g. 123/456: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status_page_id"), int64(pageID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.Itoa(id))...)
+}
+
+// parseStatusPageIncidentImportID splits a `page_id/incident_id` import ID
+func parseStatusPageIncidentImportID(importID string) (pageID, id int, err error) {
+	pagePart, idPart, ok := strings.Cut(importID, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("missing / in %q", importID)
+	}
+
+	pageID, err = strconv.Atoi(pagePart)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid status page ID %q", pagePart)
+	}
+	id, err = strconv.Atoi(idPart)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid incident ID %q", idPart)
+	}
+
+	return pageID, id, nil
+}
+
+// statusPageIncidentIDs returns the status page ID and incident ID of data
+func statusPageIncidentIDs(data *StatusPageIncidentResourceModel) (int, int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		diags.AddError("Invalid status page incident ID", fmt.Sprintf("Failed to parse status page incident ID: %s", err.Error()))
+		return 0, 0, diags
+	}
+
+	return int(data.StatusPageID.ValueInt64()), id, diags
+}
+
+// terraformToAPIModel converts Terraform model to API client model
+func (r *StatusPageIncidentResource) terraformToAPIModel(data *StatusPageIncidentResourceModel) *client.StatusPageIncident {
+	incident := &client.StatusPageIncident{
+		Title:  data.Title.ValueString(),
+		Body:   data.Body.ValueString(),
+		Impact: data.Impact.ValueString(),
+	}
+
+	if !data.IncidentID.IsNull() {
+		incidentID := int(data.IncidentID.ValueInt64())
+		incident.IncidentID = &incidentID
+	}
+
+	return incident
+}
+
+// apiToTerraformModel converts API client model to Terraform model
+func (r *StatusPageIncidentResource) apiToTerraformModel(incident *client.StatusPageIncident, data *StatusPageIncidentResourceModel) {
+	if incident.ID != nil {
+		data.ID = types.StringValue(strconv.Itoa(*incident.ID))
+	}
+	data.IncidentID = int64PointerValue(incident.IncidentID)
+	data.Title = types.StringValue(incident.Title)
+	data.Body = types.StringValue(incident.Body)
+	data.Impact = types.StringValue(incident.Impact)
+	data.PublishedAt = timestampValue(incident.PublishedAt, data.PublishedAt)
+	data.CreatedAt = timestampValue(incident.CreatedAt, data.CreatedAt)
+	data.UpdatedAt = timestampValue(incident.UpdatedAt, data.UpdatedAt)
+}
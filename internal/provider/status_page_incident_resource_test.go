@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccStatusPageIncidentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccStatusPageIncidentResourceConfig("partialOutage"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_status_page_incident.test",
+						tfjsonpath.New("impact"),
+						knownvalue.StringExact("partialOutage"),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_status_page_incident.test",
+						tfjsonpath.New("published_at"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "phare_status_page_incident.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["phare_status_page_incident.test"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["status_page_id"], rs.Primary.ID), nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccStatusPageIncidentResourceConfig("degradedPerformance"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_status_page_incident.test",
+						tfjsonpath.New("impact"),
+						knownvalue.StringExact("degradedPerformance"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccStatusPageIncidentResourceConfig(impact string) string {
+	return testAccStatusPageResourceConfig("TF Test Incident Page", "Incident Status") + fmt.Sprintf(`
+resource "phare_uptime_incident" "status_page_test" {
+  title  = "TF Test Status Page Incident"
+  impact = %[1]q
+  state  = "investigating"
+}
+
+resource "phare_status_page_incident" "test" {
+  status_page_id = tonumber(phare_status_page.test.id)
+  incident_id    = tonumber(phare_uptime_incident.status_page_test.id)
+  title          = "Slow API responses"
+  body           = "We are **investigating** slow API responses."
+  impact         = %[1]q
+}
+`, impact)
+}
+
+func TestParseStatusPageIncidentImportID(t *testing.T) {
+	tests := []struct {
+		importID   string
+		wantPageID int
+		wantID     int
+		wantError  bool
+	}{
+		{importID: "123/456", wantPageID: 123, wantID: 456},
+		{importID: "456", wantError: true},
+		{importID: "abc/456", wantError: true},
+		{importID: "123/", wantError: true},
+		{importID: "123/456/789", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importID, func(t *testing.T) {
+			pageID, id, err := parseStatusPageIncidentImportID(tt.importID)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("parseStatusPageIncidentImportID() error = %v, want error %v", err, tt.wantError)
+			}
+			if pageID != tt.wantPageID || id != tt.wantID {
+				t.Errorf("parseStatusPageIncidentImportID() = %d, %d, want %d, %d", pageID, id, tt.wantPageID, tt.wantID)
+			}
+		})
+	}
+}
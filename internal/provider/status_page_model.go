@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/phare/terraform-provider-phare/internal/client"
+	"github.com/phare/terraform-provider-phare/internal/planmodifiers"
 )
 
 // terraformToAPIModel converts Terraform model to API client model
@@ -86,6 +87,7 @@ func (r *StatusPageResource) apiToTerraformModel(ctx context.Context, page *clie
 
 	data.Subdomain = types.StringPointerValue(page.Subdomain)
 	data.Domain = types.StringPointerValue(page.Domain)
+	data.PublicURL = statusPagePublicURL(data.Subdomain, data.Domain)
 
 	if page.Timeframe != nil {
 		data.Timeframe = types.Int64Value(int64(*page.Timeframe))
@@ -93,35 +95,23 @@ func (r *StatusPageResource) apiToTerraformModel(ctx context.Context, page *clie
 		data.Timeframe = types.Int64Null()
 	}
 
+	// validate_components_exist and warn_on_duplicate_component_names are
+	// provider-side settings, default them after import
+	if data.ValidateComponentsExist.IsNull() {
+		data.ValidateComponentsExist = types.BoolValue(false)
+	}
+	if data.WarnOnDuplicateNames.IsNull() {
+		data.WarnOnDuplicateNames = types.BoolValue(false)
+	}
+
 	data.Logo = types.StringPointerValue(page.Logo)
 	data.Favicon = types.StringPointerValue(page.Favicon)
 
-	if page.CreatedAt != nil {
-		data.CreatedAt = types.StringValue(*page.CreatedAt)
-	}
-	if page.UpdatedAt != nil {
-		data.UpdatedAt = types.StringValue(*page.UpdatedAt)
-	}
+	data.CreatedAt = timestampValue(page.CreatedAt, data.CreatedAt)
+	data.UpdatedAt = timestampValue(page.UpdatedAt, data.UpdatedAt)
 
 	// Convert colors
-	colorsObj, diagObj := types.ObjectValue(
-		map[string]attr.Type{
-			"operational":          types.StringType,
-			"degraded_performance": types.StringType,
-			"partial_outage":       types.StringType,
-			"major_outage":         types.StringType,
-			"maintenance":          types.StringType,
-			"empty":                types.StringType,
-		},
-		map[string]attr.Value{
-			"operational":          types.StringValue(page.Colors.Operational),
-			"degraded_performance": types.StringValue(page.Colors.DegradedPerformance),
-			"partial_outage":       types.StringValue(page.Colors.PartialOutage),
-			"major_outage":         types.StringValue(page.Colors.MajorOutage),
-			"maintenance":          types.StringValue(page.Colors.Maintenance),
-			"empty":                types.StringValue(page.Colors.Empty),
-		},
-	)
+	colorsObj, diagObj := statusPageColorsValue(page.Colors)
 	diags.Append(diagObj...)
 	data.Colors = colorsObj
 
@@ -151,6 +141,73 @@ func (r *StatusPageResource) apiToTerraformModel(ctx context.Context, page *clie
 	)
 	diags.Append(diagList...)
 	data.Components = componentList
+	data.ComponentCount = types.Int64Value(int64(len(page.Components)))
+
+	return diags
+}
+
+// statusPagePublicURL returns the URL a status page is published at: its
+// custom domain when set, otherwise its phare.io subdomain
+func statusPagePublicURL(subdomain, domain types.String) types.String {
+	if domain.ValueString() != "" {
+		return types.StringValue("https://" + domain.ValueString())
+	}
+	if subdomain.ValueString() != "" {
+		return types.StringValue(fmt.Sprintf("https://%s.status.phare.io", subdomain.ValueString()))
+	}
+	return types.StringNull()
+}
+
+// keepPlannedNormalizedValues keeps the planned website_url and colors in
+// data when the API stored equivalent values, as the state after an apply must
+// match the plan
+func keepPlannedNormalizedValues(ctx context.Context, planned, data *StatusPageResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.WebsiteURL = planmodifiers.PlannedString(planned.WebsiteURL, data.WebsiteURL.ValueString(), planmodifiers.TrimSpace)
+
+	if planned.Colors.IsNull() || planned.Colors.IsUnknown() {
+		return diags
+	}
 
+	var plannedColors, storedColors StatusPageColorsModel
+	diags.Append(planned.Colors.As(ctx, &plannedColors, basetypes.ObjectAsOptions{})...)
+	diags.Append(data.Colors.As(ctx, &storedColors, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	colors, diagObj := statusPageColorsValue(client.StatusPageColors{
+		Operational:         planmodifiers.PlannedString(plannedColors.Operational, storedColors.Operational.ValueString(), planmodifiers.Lowercase).ValueString(),
+		DegradedPerformance: planmodifiers.PlannedString(plannedColors.DegradedPerformance, storedColors.DegradedPerformance.ValueString(), planmodifiers.Lowercase).ValueString(),
+		PartialOutage:       planmodifiers.PlannedString(plannedColors.PartialOutage, storedColors.PartialOutage.ValueString(), planmodifiers.Lowercase).ValueString(),
+		MajorOutage:         planmodifiers.PlannedString(plannedColors.MajorOutage, storedColors.MajorOutage.ValueString(), planmodifiers.Lowercase).ValueString(),
+		Maintenance:         planmodifiers.PlannedString(plannedColors.Maintenance, storedColors.Maintenance.ValueString(), planmodifiers.Lowercase).ValueString(),
+		Empty:               planmodifiers.PlannedString(plannedColors.Empty, storedColors.Empty.ValueString(), planmodifiers.Lowercase).ValueString(),
+	})
+	diags.Append(diagObj...)
+	data.Colors = colors
 	return diags
 }
+
+// statusPageColorsValue converts status page colors to a colors object
+func statusPageColorsValue(colors client.StatusPageColors) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(
+		map[string]attr.Type{
+			"operational":          types.StringType,
+			"degraded_performance": types.StringType,
+			"partial_outage":       types.StringType,
+			"major_outage":         types.StringType,
+			"maintenance":          types.StringType,
+			"empty":                types.StringType,
+		},
+		map[string]attr.Value{
+			"operational":          types.StringValue(colors.Operational),
+			"degraded_performance": types.StringValue(colors.DegradedPerformance),
+			"partial_outage":       types.StringValue(colors.PartialOutage),
+			"major_outage":         types.StringValue(colors.MajorOutage),
+			"maintenance":          types.StringValue(colors.Maintenance),
+			"empty":                types.StringValue(colors.Empty),
+		},
+	)
+}
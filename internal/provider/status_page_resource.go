@@ -10,20 +10,39 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/phare/terraform-provider-phare/internal/client"
+	"github.com/phare/terraform-provider-phare/internal/planmodifiers"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &StatusPageResource{}
 var _ resource.ResourceWithImportState = &StatusPageResource{}
+var _ resource.ResourceWithModifyPlan = &StatusPageResource{}
+
+// statusPageAPIFields maps status page validation errors returned by the API
+// to resource attributes. The schema only accepts valid timeframes, so a
+// rejected timeframe means the account's plan doesn't include it.
+var statusPageAPIFields = map[string]apiField{
+	"name":        {path: path.Root("name")},
+	"title":       {path: path.Root("title")},
+	"subdomain":   {path: path.Root("subdomain")},
+	"domain":      {path: path.Root("domain")},
+	"website_url": {path: path.Root("website_url")},
+	"timeframe": {
+		path:   path.Root("timeframe"),
+		detail: "The configured timeframe isn't available on your Phare plan, 90-day history requires a higher plan tier. Use a timeframe of 30 or 60 days or upgrade your plan.",
+	},
+}
 
 func NewStatusPageResource() resource.Resource {
 	return &StatusPageResource{}
@@ -31,26 +50,41 @@ func NewStatusPageResource() resource.Resource {
 
 // StatusPageResource defines the resource implementation.
 type StatusPageResource struct {
-	client *client.Client
+	client        statusPageResourceAPI
+	logChanges    bool
+	defaultColors client.StatusPageColors
+}
+
+// statusPageResourceAPI is the set of client operations used by the status
+// page resource. Monitors are read to validate components.
+type statusPageResourceAPI interface {
+	client.StatusPageAPI
+	client.MonitorAPI
+	ListMonitors(ctx context.Context) ([]client.Monitor, error)
 }
 
 // StatusPageResourceModel describes the resource data model.
 type StatusPageResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Name                types.String `tfsdk:"name"`
-	Title               types.String `tfsdk:"title"`
-	Description         types.String `tfsdk:"description"`
-	SearchEngineIndexed types.Bool   `tfsdk:"search_engine_indexed"`
-	WebsiteURL          types.String `tfsdk:"website_url"`
-	Subdomain           types.String `tfsdk:"subdomain"`
-	Domain              types.String `tfsdk:"domain"`
-	Timeframe           types.Int64  `tfsdk:"timeframe"`
-	Colors              types.Object `tfsdk:"colors"`
-	Components          types.List   `tfsdk:"components"`
-	Logo                types.String `tfsdk:"logo"`
-	Favicon             types.String `tfsdk:"favicon"`
-	CreatedAt           types.String `tfsdk:"created_at"`
-	UpdatedAt           types.String `tfsdk:"updated_at"`
+	ID                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Title                   types.String `tfsdk:"title"`
+	Description             types.String `tfsdk:"description"`
+	SearchEngineIndexed     types.Bool   `tfsdk:"search_engine_indexed"`
+	WebsiteURL              types.String `tfsdk:"website_url"`
+	Subdomain               types.String `tfsdk:"subdomain"`
+	Domain                  types.String `tfsdk:"domain"`
+	PublicURL               types.String `tfsdk:"public_url"`
+	Timeframe               types.Int64  `tfsdk:"timeframe"`
+	Colors                  types.Object `tfsdk:"colors"`
+	Components              types.List   `tfsdk:"components"`
+	ComponentCount          types.Int64  `tfsdk:"component_count"`
+	ValidateComponentsExist types.Bool   `tfsdk:"validate_components_exist"`
+	WarnOnDuplicateNames    types.Bool   `tfsdk:"warn_on_duplicate_component_names"`
+	Logo                    types.String `tfsdk:"logo"`
+	Favicon                 types.String `tfsdk:"favicon"`
+	CreatedAt               types.String `tfsdk:"created_at"`
+	UpdatedAt               types.String `tfsdk:"updated_at"`
+	BaseURL                 types.String `tfsdk:"base_url"`
 }
 
 type StatusPageColorsModel struct {
@@ -114,6 +148,9 @@ func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(250),
 				},
+				PlanModifiers: []planmodifier.String{
+					planmodifiers.NormalizedString(planmodifiers.TrimSpace),
+				},
 			},
 			"subdomain": schema.StringAttribute{
 				MarkdownDescription: "Subdomain for the status page (e.g., 'status' for status.phare.io, creates {subdomain}.status.phare.io)",
@@ -126,6 +163,13 @@ func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "Custom domain for the status page",
 				Optional:            true,
 			},
+			"public_url": schema.StringAttribute{
+				MarkdownDescription: "Public URL of the status page: the custom `domain` when set, otherwise `https://{subdomain}.status.phare.io`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"timeframe": schema.Int64Attribute{
 				MarkdownDescription: "Number of days of history to display (30, 60, or 90)",
 				Required:            true,
@@ -134,32 +178,69 @@ func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"colors": schema.SingleNestedAttribute{
-				MarkdownDescription: "Color scheme for different status states",
-				Required:            true,
+				MarkdownDescription: "Color scheme for different status states. Defaults to the provider `default_colors`",
+				Optional:            true,
+				Computed:            true,
 				Attributes: map[string]schema.Attribute{
 					"operational": schema.StringAttribute{
 						MarkdownDescription: "Color for operational status (hex color code)",
 						Required:            true,
+						Validators: []validator.String{
+							hexColorValidator{},
+						},
+						PlanModifiers: []planmodifier.String{
+							planmodifiers.NormalizedString(planmodifiers.Lowercase),
+						},
 					},
 					"degraded_performance": schema.StringAttribute{
 						MarkdownDescription: "Color for degraded performance status (hex color code)",
 						Required:            true,
+						Validators: []validator.String{
+							hexColorValidator{},
+						},
+						PlanModifiers: []planmodifier.String{
+							planmodifiers.NormalizedString(planmodifiers.Lowercase),
+						},
 					},
 					"partial_outage": schema.StringAttribute{
 						MarkdownDescription: "Color for partial outage status (hex color code)",
 						Required:            true,
+						Validators: []validator.String{
+							hexColorValidator{},
+						},
+						PlanModifiers: []planmodifier.String{
+							planmodifiers.NormalizedString(planmodifiers.Lowercase),
+						},
 					},
 					"major_outage": schema.StringAttribute{
 						MarkdownDescription: "Color for major outage status (hex color code)",
 						Required:            true,
+						Validators: []validator.String{
+							hexColorValidator{},
+						},
+						PlanModifiers: []planmodifier.String{
+							planmodifiers.NormalizedString(planmodifiers.Lowercase),
+						},
 					},
 					"maintenance": schema.StringAttribute{
 						MarkdownDescription: "Color for maintenance status (hex color code)",
 						Required:            true,
+						Validators: []validator.String{
+							hexColorValidator{},
+						},
+						PlanModifiers: []planmodifier.String{
+							planmodifiers.NormalizedString(planmodifiers.Lowercase),
+						},
 					},
 					"empty": schema.StringAttribute{
 						MarkdownDescription: "Color for empty/unknown status (hex color code)",
 						Required:            true,
+						Validators: []validator.String{
+							hexColorValidator{},
+						},
+						PlanModifiers: []planmodifier.String{
+							planmodifiers.NormalizedString(planmodifiers.Lowercase),
+						},
 					},
 				},
 			},
@@ -182,6 +263,24 @@ func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequ
 					},
 				},
 			},
+			"component_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of components displayed on the status page",
+				Computed:            true,
+			},
+			"validate_components_exist": schema.BoolAttribute{
+				MarkdownDescription: "Check during plan that every `uptime/monitor` component references an existing monitor. " +
+					"Requires one API request per component, defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"warn_on_duplicate_component_names": schema.BoolAttribute{
+				MarkdownDescription: "Warn during plan when `uptime/monitor` components reference different monitors sharing the same name, " +
+					"which display ambiguously on the status page. Requires one API request, defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"logo": schema.StringAttribute{
 				MarkdownDescription: "Logo file path or URL (jpeg, png, or svg)",
 				Optional:            true,
@@ -201,6 +300,7 @@ func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "Timestamp when the status page was last updated",
 				Computed:            true,
 			},
+			"base_url": baseURLAttribute(),
 		},
 	}
 }
@@ -220,6 +320,8 @@ func (r *StatusPageResource) Configure(ctx context.Context, req resource.Configu
 	}
 
 	r.client = client
+	r.logChanges = client.LogChanges()
+	r.defaultColors = client.DefaultColors()
 }
 
 func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -239,9 +341,10 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 
 	tflog.Debug(ctx, "Creating status page", map[string]any{"name": data.Name.ValueString()})
 
-	created, err := r.client.CreateStatusPage(ctx, page)
+	api := scopedClient(r.client, data.BaseURL)
+	created, err := api.CreateStatusPage(ctx, page)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to create status page", err.Error())
+		addAPIError(&resp.Diagnostics, "Failed to create status page", err, statusPageAPIFields)
 		return
 	}
 
@@ -252,14 +355,17 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// Read back the status page to get all fields
-	fullPage, err := r.client.GetStatusPage(ctx, *created.ID)
+	fullPage, err := api.GetStatusPage(ctx, *created.ID)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read created status page", err.Error())
 		return
 	}
 
+	// Keep the planned website_url and colors when the API only normalized them
+	planned := data
 	diags = r.apiToTerraformModel(ctx, fullPage, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(keepPlannedNormalizedValues(ctx, &planned, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -283,7 +389,14 @@ func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	page, err := r.client.GetStatusPage(ctx, id)
+	api := scopedClient(r.client, data.BaseURL)
+	page, err := api.GetStatusPage(ctx, id)
+	if client.IsNotFound(err) {
+		// Deleted outside Terraform, remove it so that it's planned for creation
+		tflog.Warn(ctx, "Status page not found, removing from state", map[string]any{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read status page", err.Error())
 		return
@@ -299,9 +412,10 @@ func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data StatusPageResourceModel
+	var data, state StatusPageResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -312,7 +426,16 @@ func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// Only apply the update if the page wasn't edited since it was last read,
+	// status pages are commonly edited in the Phare dashboard
+	if !state.UpdatedAt.IsNull() && !state.UpdatedAt.IsUnknown() {
+		page.UpdatedAt = stringPtr(state.UpdatedAt.ValueString())
+	}
+
 	tflog.Debug(ctx, "Updating status page", map[string]any{"id": data.ID.ValueString()})
+	if r.logChanges {
+		logChangedAttributes(ctx, "Updating status page attributes", data.ID.ValueString(), req.Plan, req.State)
+	}
 
 	id, err := strconv.Atoi(data.ID.ValueString())
 	if err != nil {
@@ -320,14 +443,26 @@ func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	updated, err := r.client.UpdateStatusPage(ctx, id, page)
+	api := scopedClient(r.client, data.BaseURL)
+	updated, err := api.UpdateStatusPage(ctx, id, page)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to update status page", err.Error())
+		if client.IsConflict(err) {
+			resp.Diagnostics.AddError(
+				"Status page modified outside Terraform",
+				fmt.Sprintf("Status page %d was changed since it was last read (updated_at %s), so the update was not applied to avoid overwriting those changes. "+
+					"Run `terraform apply -refresh-only` to review the changes, then plan and apply again.", id, state.UpdatedAt.ValueString()),
+			)
+			return
+		}
+		addAPIError(&resp.Diagnostics, "Failed to update status page", err, statusPageAPIFields)
 		return
 	}
 
+	// Keep the planned website_url and colors when the API only normalized them
+	planned := data
 	diags = r.apiToTerraformModel(ctx, updated, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(keepPlannedNormalizedValues(ctx, &planned, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -351,12 +486,169 @@ func (r *StatusPageResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	if err := r.client.DeleteStatusPage(ctx, id); err != nil {
+	api := scopedClient(r.client, data.BaseURL)
+	if err := api.DeleteStatusPage(ctx, id); err != nil {
 		resp.Diagnostics.AddError("Failed to delete status page", err.Error())
 		return
 	}
 }
 
+func (r *StatusPageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The public URL follows subdomain and domain changes, the state value
+	// kept by UseStateForUnknown is only valid while both are unchanged
+	var subdomain, domain types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("subdomain"), &subdomain)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	publicURL := types.StringUnknown()
+	if !subdomain.IsUnknown() && !domain.IsUnknown() {
+		publicURL = statusPagePublicURL(subdomain, domain)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("public_url"), publicURL)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to validate before the provider is configured
+	if r.client == nil {
+		return
+	}
+
+	// Pages without colors use the provider default_colors
+	var colors types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("colors"), &colors)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if colors.IsNull() {
+		defaults, diags := statusPageColorsValue(r.defaultColors)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("colors"), defaults)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var data StatusPageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Components.IsUnknown() ||
+		(!data.ValidateComponentsExist.ValueBool() && !data.WarnOnDuplicateNames.ValueBool()) {
+		return
+	}
+
+	var components []StatusComponentModel
+	resp.Diagnostics.Append(data.Components.ElementsAs(ctx, &components, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api := scopedClient(r.client, data.BaseURL)
+
+	if data.ValidateComponentsExist.ValueBool() {
+		validateComponentsExist(ctx, api, components, &resp.Diagnostics)
+	}
+
+	if data.WarnOnDuplicateNames.ValueBool() {
+		monitors, err := api.ListMonitors(ctx)
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("components"),
+				"Unable to check component names",
+				fmt.Sprintf("Failed to list uptime monitors: %s", err.Error()),
+			)
+			return
+		}
+
+		warnOnDuplicateComponentNames(components, monitors, &resp.Diagnostics)
+	}
+}
+
+// validateComponentsExist adds an error for every uptime/monitor component
+// referencing a monitor that doesn't exist
+func validateComponentsExist(ctx context.Context, api client.MonitorAPI, components []StatusComponentModel, diags *diag.Diagnostics) {
+	for i, c := range components {
+		if c.ComponentableType.ValueString() != "uptime/monitor" || c.ComponentableID.IsUnknown() {
+			continue
+		}
+
+		monitorID := int(c.ComponentableID.ValueInt64())
+		if _, err := api.GetMonitor(ctx, monitorID); err != nil {
+			componentPath := path.Root("components").AtListIndex(i).AtName("componentable_id")
+			if client.IsNotFound(err) {
+				diags.AddAttributeError(
+					componentPath,
+					"Component monitor not found",
+					fmt.Sprintf("No uptime monitor exists with ID %d.", monitorID),
+				)
+			} else {
+				diags.AddAttributeWarning(
+					componentPath,
+					"Unable to verify component monitor",
+					fmt.Sprintf("Failed to look up uptime monitor %d: %s", monitorID, err.Error()),
+				)
+			}
+		}
+	}
+}
+
+// warnOnDuplicateComponentNames adds a warning for every uptime/monitor
+// component whose monitor shares its name with the monitor of an earlier
+// component. Components referencing the same monitor twice aren't reported.
+func warnOnDuplicateComponentNames(components []StatusComponentModel, monitors []client.Monitor, diags *diag.Diagnostics) {
+	names := make(map[int]string, len(monitors))
+	for _, monitor := range monitors {
+		if monitor.ID != nil {
+			names[*monitor.ID] = monitor.Name
+		}
+	}
+
+	// First monitor ID and component index seen for each name
+	type firstComponent struct {
+		monitorID int
+		index     int
+	}
+	seen := map[string]firstComponent{}
+
+	for i, c := range components {
+		if c.ComponentableType.ValueString() != "uptime/monitor" || c.ComponentableID.IsUnknown() {
+			continue
+		}
+
+		monitorID := int(c.ComponentableID.ValueInt64())
+		name, ok := names[monitorID]
+		if !ok {
+			continue
+		}
+
+		first, ok := seen[name]
+		if !ok {
+			seen[name] = firstComponent{monitorID: monitorID, index: i}
+			continue
+		}
+		if first.monitorID == monitorID {
+			continue
+		}
+
+		diags.AddAttributeWarning(
+			path.Root("components").AtListIndex(i).AtName("componentable_id"),
+			"Duplicate component name",
+			fmt.Sprintf("Monitor %d and monitor %d (component %d) are both named %q and will display ambiguously on the status page. "+
+				"Consider renaming one of them.", monitorID, first.monitorID, first.index, name),
+		)
+	}
+}
+
 func (r *StatusPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
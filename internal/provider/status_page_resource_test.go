@@ -4,13 +4,25 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/phare/terraform-provider-phare/internal/client"
 )
 
 func TestAccStatusPageResource(t *testing.T) {
@@ -37,6 +49,11 @@ func TestAccStatusPageResource(t *testing.T) {
 						tfjsonpath.New("search_engine_indexed"),
 						knownvalue.Bool(false),
 					),
+					statecheck.ExpectKnownValue(
+						"phare_status_page.test",
+						tfjsonpath.New("public_url"),
+						knownvalue.StringExact("https://tf-test-status.status.phare.io"),
+					),
 				},
 			},
 			// ImportState testing
@@ -54,12 +71,130 @@ func TestAccStatusPageResource(t *testing.T) {
 						tfjsonpath.New("title"),
 						knownvalue.StringExact("Updated Status"),
 					),
+					statecheck.ExpectKnownValue(
+						"phare_status_page.test",
+						tfjsonpath.New("public_url"),
+						knownvalue.StringExact("https://tf-test-status.status.phare.io"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccStatusPageResource_MultipleComponents(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccStatusPageResourceConfig_MultipleComponents(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_status_page.test",
+						tfjsonpath.New("components"),
+						knownvalue.ListSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_status_page.test",
+						tfjsonpath.New("components").AtSliceIndex(1).AtMapKey("componentable_type"),
+						knownvalue.StringExact("uptime/monitor"),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_status_page.test",
+						tfjsonpath.New("component_count"),
+						knownvalue.Int64Exact(2),
+					),
 				},
 			},
+			// ImportState testing reconstructs components from the API
+			{
+				ResourceName:      "phare_status_page.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func TestAccStatusPageResource_ValidateComponentsExist(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStatusPageResourceConfig_MissingComponent(999999999),
+				ExpectError: regexp.MustCompile(`No uptime monitor exists with ID 999999999`),
+			},
+		},
+	})
+}
+
+func TestWarnOnDuplicateComponentNames(t *testing.T) {
+	monitor := func(id int, name string) client.Monitor {
+		return client.Monitor{ID: &id, Name: name}
+	}
+	component := func(componentableType string, id int64) StatusComponentModel {
+		return StatusComponentModel{
+			ComponentableType: types.StringValue(componentableType),
+			ComponentableID:   types.Int64Value(id),
+		}
+	}
+
+	monitors := []client.Monitor{
+		monitor(1, "API"),
+		monitor(2, "API"),
+		monitor(3, "Website"),
+	}
+
+	tests := []struct {
+		name         string
+		components   []StatusComponentModel
+		wantWarnings int
+	}{
+		{
+			name:         "distinct names",
+			components:   []StatusComponentModel{component("uptime/monitor", 1), component("uptime/monitor", 3)},
+			wantWarnings: 0,
+		},
+		{
+			name:         "different monitors sharing a name",
+			components:   []StatusComponentModel{component("uptime/monitor", 1), component("uptime/monitor", 2)},
+			wantWarnings: 1,
+		},
+		{
+			name:         "same monitor twice",
+			components:   []StatusComponentModel{component("uptime/monitor", 1), component("uptime/monitor", 1)},
+			wantWarnings: 0,
+		},
+		{
+			name:         "unknown monitor",
+			components:   []StatusComponentModel{component("uptime/monitor", 1), component("uptime/monitor", 4)},
+			wantWarnings: 0,
+		},
+		{
+			name:         "other component type",
+			components:   []StatusComponentModel{component("uptime/monitor", 1), component("uptime/group", 2)},
+			wantWarnings: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			warnOnDuplicateComponentNames(tt.components, monitors, &diags)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			if got := diags.WarningsCount(); got != tt.wantWarnings {
+				t.Errorf("got %d warnings, want %d: %v", got, tt.wantWarnings, diags)
+			}
+		})
+	}
+}
+
 func testAccStatusPageResourceConfig(name, title string) string {
 	return fmt.Sprintf(`
 resource "phare_uptime_monitor" "status_test" {
@@ -113,3 +248,351 @@ resource "phare_status_page" "test" {
 }
 `, name, title)
 }
+
+func testAccStatusPageResourceConfig_MissingComponent(monitorID int) string {
+	return fmt.Sprintf(`
+resource "phare_status_page" "test" {
+  name                      = "Test Missing Component"
+  title                     = "Test Status"
+  description               = "Test status page description"
+  search_engine_indexed     = false
+  website_url               = "https://example.com"
+  subdomain                 = "tf-test-missing"
+  timeframe                 = 90
+  validate_components_exist = true
+
+  colors = {
+    operational          = "#16a34a"
+    degraded_performance = "#fbbf24"
+    partial_outage       = "#f59e0b"
+    major_outage         = "#ef4444"
+    maintenance          = "#6366f1"
+    empty                = "#d3d3d3"
+  }
+
+  components = [
+    {
+      componentable_type = "uptime/monitor"
+      componentable_id   = %[1]d
+    }
+  ]
+}
+`, monitorID)
+}
+
+func testAccStatusPageResourceConfig_MultipleComponents() string {
+	return `
+resource "phare_uptime_monitor" "http" {
+  name     = "Status Page HTTP Component"
+  protocol = "http"
+
+  http_request = {
+    method = "GET"
+    url    = "https://immich.app"
+  }
+
+  interval                = 60
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+}
+
+resource "phare_uptime_monitor" "tcp" {
+  name     = "Status Page TCP Component"
+  protocol = "tcp"
+
+  tcp_request = {
+    host       = "8.8.8.8"
+    port       = "53"
+    connection = "plain"
+  }
+
+  interval                = 60
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+}
+
+resource "phare_status_page" "test" {
+  name                  = "Test Multiple Components"
+  title                 = "Test Status"
+  description           = "Test status page description"
+  search_engine_indexed = false
+  website_url           = "https://example.com"
+  subdomain             = "tf-test-multi"
+  timeframe             = 90
+
+  colors = {
+    operational          = "#16a34a"
+    degraded_performance = "#fbbf24"
+    partial_outage       = "#f59e0b"
+    major_outage         = "#ef4444"
+    maintenance          = "#6366f1"
+    empty                = "#d3d3d3"
+  }
+
+  components = [
+    {
+      componentable_type = "uptime/monitor"
+      componentable_id   = tonumber(phare_uptime_monitor.http.id)
+    },
+    {
+      componentable_type = "uptime/monitor"
+      componentable_id   = tonumber(phare_uptime_monitor.tcp.id)
+    }
+  ]
+}
+`
+}
+
+func TestStatusPageResourceDefaultColors(t *testing.T) {
+	ctx := context.Background()
+
+	c, err := client.NewClient("test-token", "", client.WithDefaultColors(client.StatusPageColors{Operational: "#000000"}))
+	if err != nil {
+		t.Fatalf("NewClient() unexpected error: %v", err)
+	}
+
+	r := &StatusPageResource{}
+	r.Configure(ctx, fwresource.ConfigureRequest{ProviderData: c}, &fwresource.ConfigureResponse{})
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	configured, diags := statusPageColorsValue(client.StatusPageColors{
+		Operational:         "#111111",
+		DegradedPerformance: "#222222",
+		PartialOutage:       "#333333",
+		MajorOutage:         "#444444",
+		Maintenance:         "#555555",
+		Empty:               "#666666",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	defaults, diags := statusPageColorsValue(client.StatusPageColors{
+		Operational:         "#000000",
+		DegradedPerformance: client.DefaultStatusPageColors.DegradedPerformance,
+		PartialOutage:       client.DefaultStatusPageColors.PartialOutage,
+		MajorOutage:         client.DefaultStatusPageColors.MajorOutage,
+		Maintenance:         client.DefaultStatusPageColors.Maintenance,
+		Empty:               client.DefaultStatusPageColors.Empty,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	tests := []struct {
+		name   string
+		colors types.Object
+		want   types.Object
+	}{
+		{
+			name:   "omitted colors use the provider defaults",
+			colors: types.ObjectNull(defaults.AttributeTypes(ctx)),
+			want:   defaults,
+		},
+		{
+			name:   "configured colors override the provider defaults",
+			colors: configured,
+			want:   configured,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			state := tfsdk.State(config)
+			diags := state.SetAttribute(ctx, path.Root("name"), "Status")
+			diags.Append(state.SetAttribute(ctx, path.Root("colors"), tt.colors)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			config.Raw = state.Raw
+
+			plan := tfsdk.Plan(state)
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Config: config, Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var got types.Object
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("colors"), &got)...)
+			if !got.Equal(tt.want) {
+				t.Errorf("colors = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusPageResourcePublicURL(t *testing.T) {
+	ctx := context.Background()
+	r := &StatusPageResource{}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name      string
+		subdomain types.String
+		domain    types.String
+		prior     types.String
+		want      types.String
+	}{
+		{
+			name:      "subdomain",
+			subdomain: types.StringValue("acme"),
+			domain:    types.StringNull(),
+			want:      types.StringValue("https://acme.status.phare.io"),
+		},
+		{
+			name:      "custom domain",
+			subdomain: types.StringValue("acme"),
+			domain:    types.StringValue("status.acme.com"),
+			want:      types.StringValue("https://status.acme.com"),
+		},
+		{
+			name:      "changed subdomain",
+			subdomain: types.StringValue("acme-v2"),
+			domain:    types.StringNull(),
+			prior:     types.StringValue("https://acme.status.phare.io"),
+			want:      types.StringValue("https://acme-v2.status.phare.io"),
+		},
+		{
+			name:      "unknown domain",
+			subdomain: types.StringValue("acme"),
+			domain:    types.StringUnknown(),
+			prior:     types.StringValue("https://acme.status.phare.io"),
+			want:      types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			diags := state.SetAttribute(ctx, path.Root("subdomain"), tt.subdomain)
+			diags.Append(state.SetAttribute(ctx, path.Root("domain"), tt.domain)...)
+			if !tt.prior.IsNull() {
+				// UseStateForUnknown plans the prior value
+				diags.Append(state.SetAttribute(ctx, path.Root("public_url"), tt.prior)...)
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			plan := tfsdk.Plan(state)
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Config: tfsdk.Config(state), Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var got types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("public_url"), &got)...)
+			if !got.Equal(tt.want) {
+				t.Errorf("public_url = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusPageResourceColorsValidation(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	(&StatusPageResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	colors, ok := schemaResp.Schema.Attributes["colors"].(schema.SingleNestedAttribute)
+	if !ok {
+		t.Fatalf("colors is not a single nested attribute")
+	}
+
+	for _, name := range []string{"operational", "degraded_performance", "partial_outage", "major_outage", "maintenance", "empty"} {
+		t.Run(name, func(t *testing.T) {
+			attribute, ok := colors.Attributes[name].(schema.StringAttribute)
+			if !ok {
+				t.Fatalf("colors.%s is not a string attribute", name)
+			}
+
+			for value, wantError := range map[string]bool{"#16a34a": false, "#fff": false, "green": true, "#16a34aff": true} {
+				req := validator.StringRequest{
+					Path:        path.Root("colors").AtName(name),
+					ConfigValue: types.StringValue(value),
+				}
+				resp := &validator.StringResponse{}
+				for _, v := range attribute.StringValidators() {
+					v.ValidateString(ctx, req, resp)
+				}
+
+				if got := resp.Diagnostics.HasError(); got != wantError {
+					t.Errorf("%q: HasError() = %v, want %v: %v", value, got, wantError, resp.Diagnostics)
+				}
+			}
+		})
+	}
+}
+
+func TestKeepPlannedNormalizedValues(t *testing.T) {
+	ctx := context.Background()
+
+	plannedColors, diags := statusPageColorsValue(client.StatusPageColors{
+		Operational:         "#16A34A",
+		DegradedPerformance: "#EAB308",
+		PartialOutage:       "#F97316",
+		MajorOutage:         "#EF4444",
+		Maintenance:         "#3B82F6",
+		Empty:               "#D3D3D3",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	storedColors, diags := statusPageColorsValue(client.StatusPageColors{
+		Operational:         "#16a34a",
+		DegradedPerformance: "#eab308",
+		PartialOutage:       "#f97316",
+		MajorOutage:         "#000000",
+		Maintenance:         "#3b82f6",
+		Empty:               "#d3d3d3",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	planned := StatusPageResourceModel{
+		WebsiteURL: types.StringValue(" https://example.com\n"),
+		Colors:     plannedColors,
+	}
+	data := StatusPageResourceModel{
+		WebsiteURL: types.StringValue("https://example.com"),
+		Colors:     storedColors,
+	}
+
+	if diags := keepPlannedNormalizedValues(ctx, &planned, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !data.WebsiteURL.Equal(planned.WebsiteURL) {
+		t.Errorf("website_url = %v, want the planned %v", data.WebsiteURL, planned.WebsiteURL)
+	}
+
+	var colors StatusPageColorsModel
+	if diags := data.Colors.As(ctx, &colors, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("failed to read colors: %v", diags)
+	}
+	if colors.Operational.ValueString() != "#16A34A" {
+		t.Errorf("operational = %v, want the planned #16A34A", colors.Operational)
+	}
+	if colors.MajorOutage.ValueString() != "#000000" {
+		t.Errorf("major_outage = %v, want the stored #000000", colors.MajorOutage)
+	}
+}
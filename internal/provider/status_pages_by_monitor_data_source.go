@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StatusPagesByMonitorDataSource{}
+
+func NewStatusPagesByMonitorDataSource() datasource.DataSource {
+	return &StatusPagesByMonitorDataSource{}
+}
+
+// StatusPagesByMonitorDataSource defines the data source implementation.
+type StatusPagesByMonitorDataSource struct {
+	client *client.Client
+}
+
+// StatusPagesByMonitorDataSourceModel describes the data source data model.
+type StatusPagesByMonitorDataSourceModel struct {
+	MonitorID   types.Int64 `tfsdk:"monitor_id"`
+	StatusPages types.List  `tfsdk:"status_pages"`
+}
+
+func (d *StatusPagesByMonitorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_pages_by_monitor"
+}
+
+func (d *StatusPagesByMonitorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Phare status pages displaying a given uptime monitor as a component.",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the uptime monitor",
+				Required:            true,
+			},
+			"status_pages": schema.ListNestedAttribute{
+				MarkdownDescription: "The status pages displaying the monitor",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The unique identifier of the status page",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the status page",
+							Computed:            true,
+						},
+						"subdomain": schema.StringAttribute{
+							MarkdownDescription: "The subdomain of the status page",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "The custom domain of the status page",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StatusPagesByMonitorDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *StatusPagesByMonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StatusPagesByMonitorDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing status pages by monitor", map[string]any{"monitor_id": data.MonitorID.ValueInt64()})
+
+	pages, err := d.client.ListStatusPagesByMonitor(ctx, int(data.MonitorID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list status pages", err.Error())
+		return
+	}
+
+	statusPageAttrTypes := map[string]attr.Type{
+		"id":        types.Int64Type,
+		"name":      types.StringType,
+		"subdomain": types.StringType,
+		"domain":    types.StringType,
+	}
+
+	elements := make([]attr.Value, 0, len(pages))
+	for _, page := range pages {
+		var id types.Int64
+		if page.ID != nil {
+			id = types.Int64Value(int64(*page.ID))
+		} else {
+			id = types.Int64Null()
+		}
+
+		pageObj, diagObj := types.ObjectValue(
+			statusPageAttrTypes,
+			map[string]attr.Value{
+				"id":        id,
+				"name":      types.StringValue(page.Name),
+				"subdomain": types.StringPointerValue(page.Subdomain),
+				"domain":    types.StringPointerValue(page.Domain),
+			},
+		)
+		resp.Diagnostics.Append(diagObj...)
+		elements = append(elements, pageObj)
+	}
+
+	pageList, diagList := types.ListValue(types.ObjectType{AttrTypes: statusPageAttrTypes}, elements)
+	resp.Diagnostics.Append(diagList...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.StatusPages = pageList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStatusPagesByMonitorDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccStatusPagesByMonitorDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.phare_status_pages_by_monitor.test", "status_pages.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.phare_status_pages_by_monitor.test", "status_pages.0.name",
+						"phare_status_page.test", "name",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccStatusPagesByMonitorDataSourceConfig() string {
+	return testAccStatusPageResourceConfig("Test By Monitor", "Test Status") + `
+data "phare_status_pages_by_monitor" "test" {
+  monitor_id = tonumber(phare_uptime_monitor.status_test.id)
+
+  depends_on = [phare_status_page.test]
+}
+`
+}
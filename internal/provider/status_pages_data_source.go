@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StatusPagesDataSource{}
+
+func NewStatusPagesDataSource() datasource.DataSource {
+	return &StatusPagesDataSource{}
+}
+
+// StatusPagesDataSource defines the data source implementation.
+type StatusPagesDataSource struct {
+	client *client.Client
+}
+
+// StatusPagesDataSourceModel describes the data source data model.
+type StatusPagesDataSourceModel struct {
+	StatusPages types.List `tfsdk:"status_pages"`
+}
+
+func (d *StatusPagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_pages"
+}
+
+func (d *StatusPagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists all Phare status pages of the account.",
+
+		Attributes: map[string]schema.Attribute{
+			"status_pages": schema.ListNestedAttribute{
+				MarkdownDescription: "The status pages of the account",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the status page",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Internal name of the status page",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Public title displayed on the status page",
+							Computed:            true,
+						},
+						"website_url": schema.StringAttribute{
+							MarkdownDescription: "URL of the website the status page is for",
+							Computed:            true,
+						},
+						"subdomain": schema.StringAttribute{
+							MarkdownDescription: "Subdomain of the status page",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "Custom domain of the status page",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the status page was created",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the status page was last updated",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StatusPagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *StatusPagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StatusPagesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing status pages")
+
+	pages, err := d.client.ListStatusPages(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list status pages", err.Error())
+		return
+	}
+
+	pageList, diags := statusPageListValue(pages)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.StatusPages = pageList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// statusPageSummaryAttrTypes returns the attribute types of a status_pages
+// list element
+func statusPageSummaryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"title":       types.StringType,
+		"website_url": types.StringType,
+		"subdomain":   types.StringType,
+		"domain":      types.StringType,
+		"created_at":  types.StringType,
+		"updated_at":  types.StringType,
+	}
+}
+
+// statusPageListValue converts API status pages to a status_pages list value
+func statusPageListValue(pages []client.StatusPage) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elements := make([]attr.Value, 0, len(pages))
+	for _, page := range pages {
+		id := types.StringNull()
+		if page.ID != nil {
+			id = types.StringValue(strconv.Itoa(*page.ID))
+		}
+
+		pageObj, objDiags := types.ObjectValue(statusPageSummaryAttrTypes(), map[string]attr.Value{
+			"id":          id,
+			"name":        types.StringValue(page.Name),
+			"title":       types.StringValue(page.Title),
+			"website_url": types.StringValue(page.WebsiteURL),
+			"subdomain":   types.StringPointerValue(page.Subdomain),
+			"domain":      types.StringPointerValue(page.Domain),
+			"created_at":  types.StringPointerValue(page.CreatedAt),
+			"updated_at":  types.StringPointerValue(page.UpdatedAt),
+		})
+		diags.Append(objDiags...)
+		elements = append(elements, pageObj)
+	}
+
+	list, listDiags := types.ListValue(types.ObjectType{AttrTypes: statusPageSummaryAttrTypes()}, elements)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
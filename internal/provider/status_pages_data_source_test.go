@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+func TestAccStatusPagesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccStatusPagesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListNotEmpty("data.phare_status_pages.test", "status_pages"),
+					resource.TestCheckResourceAttrSet("data.phare_status_pages.test", "status_pages.0.id"),
+					resource.TestCheckResourceAttrSet("data.phare_status_pages.test", "status_pages.0.name"),
+					resource.TestCheckResourceAttrSet("data.phare_status_pages.test", "status_pages.0.title"),
+					resource.TestCheckResourceAttrSet("data.phare_status_pages.test", "status_pages.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStatusPagesDataSourceConfig() string {
+	return testAccStatusPageResourceConfig("Test List Status Pages", "Test Status") + `
+data "phare_status_pages" "test" {
+  depends_on = [phare_status_page.test]
+}
+`
+}
+
+func TestStatusPagesDataSourceStateMatchesSchema(t *testing.T) {
+	ctx := context.Background()
+	d := &StatusPagesDataSource{}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	id := 1
+	pages := []client.StatusPage{
+		{
+			ID:         &id,
+			Name:       "public",
+			Title:      "Service Status",
+			WebsiteURL: "https://example.com",
+			Subdomain:  stringPtr("status-example"),
+			CreatedAt:  stringPtr("2024-01-01T00:00:00Z"),
+		},
+	}
+
+	pageList, diags := statusPageListValue(pages)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags.Append(state.Set(ctx, &StatusPagesDataSourceModel{StatusPages: pageList})...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := len(pageList.Elements()); got != 1 {
+		t.Errorf("got %d status pages, want 1", got)
+	}
+}
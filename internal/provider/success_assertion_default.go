@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ planmodifier.Set = successAssertionsDefaultPlanModifier{}
+
+// successAssertionsDefaultPlanModifier plans unset success_assertions. They
+// are unknown on create so that the default assertion added by Phare is
+// adopted into state. On update the prior default is kept without a diff,
+// while other assertions removed from the configuration are planned to be
+// cleared, which the API may replace with its default.
+type successAssertionsDefaultPlanModifier struct{}
+
+func (m successAssertionsDefaultPlanModifier) Description(ctx context.Context) string {
+	return "Keeps the default assertion added by Phare when success_assertions is unset, and clears removed assertions."
+}
+
+func (m successAssertionsDefaultPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return "Keeps the default assertion added by Phare when `success_assertions` is unset, and clears removed assertions."
+}
+
+func (m successAssertionsDefaultPlanModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if !req.ConfigValue.IsNull() || req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	var prior []SuccessAssertionModel
+	resp.Diagnostics.Append(req.StateValue.ElementsAs(ctx, &prior, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isDefaultSuccessAssertions(prior) {
+		resp.PlanValue = req.StateValue
+		return
+	}
+
+	resp.PlanValue = types.SetUnknown(req.StateValue.ElementType(ctx))
+}
+
+// isDefaultSuccessAssertions reports whether assertions only hold the
+// `status_code` in `2xx` assertion Phare adds to monitors without assertions
+func isDefaultSuccessAssertions(assertions []SuccessAssertionModel) bool {
+	if len(assertions) != 1 {
+		return false
+	}
+
+	a := assertions[0]
+	return a.Type.ValueString() == "status_code" &&
+		a.Value.ValueString() == "2xx" &&
+		(a.Operator.IsNull() || a.Operator.ValueString() == defaultAssertionOperators["status_code"]) &&
+		a.Property.IsNull()
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func successAssertionSetValue(t *testing.T, assertions ...SuccessAssertionModel) types.Set {
+	t.Helper()
+
+	set, diags := types.SetValueFrom(context.Background(), types.ObjectType{AttrTypes: successAssertionAttrTypes()}, assertions)
+	if diags.HasError() {
+		t.Fatalf("failed to build assertions: %v", diags)
+	}
+	return set
+}
+
+func TestSuccessAssertionsDefaultPlanModifier(t *testing.T) {
+	elementType := types.ObjectType{AttrTypes: successAssertionAttrTypes()}
+	defaultAssertion := SuccessAssertionModel{
+		Type:     types.StringValue("status_code"),
+		Operator: types.StringValue("in"),
+		Value:    types.StringValue("2xx"),
+		Property: types.StringNull(),
+	}
+	bodyAssertion := SuccessAssertionModel{
+		Type:     types.StringValue("response_body"),
+		Operator: types.StringNull(),
+		Value:    types.StringValue("ok"),
+		Property: types.StringNull(),
+	}
+
+	tests := []struct {
+		name        string
+		config      types.Set
+		state       types.Set
+		plan        types.Set
+		wantUnknown bool
+		want        types.Set
+	}{
+		{
+			name:   "configured assertions",
+			config: successAssertionSetValue(t, bodyAssertion),
+			state:  successAssertionSetValue(t, defaultAssertion),
+			plan:   successAssertionSetValue(t, bodyAssertion),
+			want:   successAssertionSetValue(t, bodyAssertion),
+		},
+		{
+			name:        "unset on create adopts the server default",
+			config:      types.SetNull(elementType),
+			state:       types.SetNull(elementType),
+			plan:        types.SetUnknown(elementType),
+			wantUnknown: true,
+		},
+		{
+			name:   "unset keeps the server default",
+			config: types.SetNull(elementType),
+			state:  successAssertionSetValue(t, defaultAssertion),
+			plan:   successAssertionSetValue(t, defaultAssertion),
+			want:   successAssertionSetValue(t, defaultAssertion),
+		},
+		{
+			name:        "removed assertions are cleared",
+			config:      types.SetNull(elementType),
+			state:       successAssertionSetValue(t, bodyAssertion),
+			plan:        successAssertionSetValue(t, bodyAssertion),
+			wantUnknown: true,
+		},
+		{
+			name:        "default removed along with other assertions is cleared",
+			config:      types.SetNull(elementType),
+			state:       successAssertionSetValue(t, defaultAssertion, bodyAssertion),
+			plan:        successAssertionSetValue(t, defaultAssertion, bodyAssertion),
+			wantUnknown: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.SetRequest{
+				ConfigValue: tt.config,
+				StateValue:  tt.state,
+				PlanValue:   tt.plan,
+			}
+			resp := &planmodifier.SetResponse{PlanValue: tt.plan}
+
+			successAssertionsDefaultPlanModifier{}.PlanModifySet(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if resp.PlanValue.IsUnknown() != tt.wantUnknown {
+				t.Fatalf("plan unknown = %v, want %v", resp.PlanValue.IsUnknown(), tt.wantUnknown)
+			}
+			if !tt.wantUnknown && !resp.PlanValue.Equal(tt.want) {
+				t.Errorf("plan = %v, want %v", resp.PlanValue, tt.want)
+			}
+		})
+	}
+}
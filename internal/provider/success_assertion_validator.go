@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// headerNameRegexp matches an HTTP header field name (RFC 9110 token).
+var headerNameRegexp = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+
+var _ validator.Object = successAssertionPropertyValidator{}
+
+// successAssertionPropertyValidator checks that a success assertion's property
+// is a valid header name for response_header assertions and unset otherwise.
+type successAssertionPropertyValidator struct{}
+
+func (v successAssertionPropertyValidator) Description(ctx context.Context) string {
+	return "property must be a valid HTTP header name when type is response_header, and unset otherwise"
+}
+
+func (v successAssertionPropertyValidator) MarkdownDescription(ctx context.Context) string {
+	return "`property` must be a valid HTTP header name when `type` is `response_header`, and unset otherwise"
+}
+
+func (v successAssertionPropertyValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attrs := req.ConfigValue.Attributes()
+	assertionType, ok := attrs["type"].(basetypes.StringValue)
+	if !ok || assertionType.IsUnknown() {
+		return
+	}
+	property, ok := attrs["property"].(basetypes.StringValue)
+	if !ok || property.IsUnknown() {
+		return
+	}
+
+	propertyPath := req.Path.AtName("property")
+
+	if assertionType.ValueString() != "response_header" {
+		if !property.IsNull() && property.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(
+				propertyPath,
+				"Unexpected Assertion Property",
+				fmt.Sprintf("property is only supported for response_header assertions, got type %q.", assertionType.ValueString()),
+			)
+		}
+		return
+	}
+
+	if property.IsNull() || !headerNameRegexp.MatchString(property.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			propertyPath,
+			"Invalid Header Name",
+			fmt.Sprintf("response_header assertions require property to be a valid HTTP header name without spaces, got %q.", property.ValueString()),
+		)
+	}
+}
+
+// numericAssertions describes the assertion types comparing a number, keyed
+// by assertion type.
+var numericAssertions = map[string]struct {
+	operators []string
+	unit      string
+}{
+	"response_time":           {operators: []string{"less_than", "greater_than"}, unit: "milliseconds"},
+	"certificate_expiry_days": {operators: []string{"less_than"}, unit: "days"},
+}
+
+var _ validator.Object = successAssertionNumericValidator{}
+
+// successAssertionNumericValidator checks that numeric assertions, such as
+// response_time, use a supported operator and a positive number as value.
+type successAssertionNumericValidator struct{}
+
+func (v successAssertionNumericValidator) Description(ctx context.Context) string {
+	return "response_time assertions must use the less_than or greater_than operator and a value in milliseconds, " +
+		"certificate_expiry_days assertions the less_than operator and a value in days"
+}
+
+func (v successAssertionNumericValidator) MarkdownDescription(ctx context.Context) string {
+	return "`response_time` assertions must use the `less_than` or `greater_than` operator and a `value` in milliseconds, " +
+		"`certificate_expiry_days` assertions the `less_than` operator and a `value` in days"
+}
+
+func (v successAssertionNumericValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attrs := req.ConfigValue.Attributes()
+	assertionType, ok := attrs["type"].(basetypes.StringValue)
+	if !ok {
+		return
+	}
+	numeric, ok := numericAssertions[assertionType.ValueString()]
+	if !ok {
+		return
+	}
+
+	if operator, ok := attrs["operator"].(basetypes.StringValue); ok && !operator.IsNull() && !operator.IsUnknown() {
+		if !slices.Contains(numeric.operators, operator.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName("operator"),
+				"Invalid Assertion Operator",
+				fmt.Sprintf("%s assertions support the %s operators, got %q.",
+					assertionType.ValueString(), strings.Join(numeric.operators, " and "), operator.ValueString()),
+			)
+		}
+	}
+
+	value, ok := attrs["value"].(basetypes.StringValue)
+	if !ok || value.IsUnknown() {
+		return
+	}
+	if n, err := strconv.Atoi(value.ValueString()); value.IsNull() || err != nil || n <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("value"),
+			"Invalid Assertion Value",
+			fmt.Sprintf("%s assertions require value to be a positive number of %s, got %q.",
+				assertionType.ValueString(), numeric.unit, value.ValueString()),
+		)
+	}
+}
+
+// assertionConflictingProtocols lists, per assertion type, the monitor
+// protocols that can't check it.
+var assertionConflictingProtocols = map[string][]string{
+	"certificate_expiry_days": {"tcp", "icmp", "dns"},
+}
+
+var _ validator.Object = successAssertionProtocolValidator{}
+
+// successAssertionProtocolValidator rejects assertions that conflict with the
+// monitor protocol attribute, such as certificate_expiry_days on tcp monitors.
+type successAssertionProtocolValidator struct{}
+
+func (v successAssertionProtocolValidator) Description(ctx context.Context) string {
+	return "certificate_expiry_days assertions conflict with the tcp, icmp and dns protocols"
+}
+
+func (v successAssertionProtocolValidator) MarkdownDescription(ctx context.Context) string {
+	return "`certificate_expiry_days` assertions conflict with the `tcp`, `icmp` and `dns` protocols"
+}
+
+func (v successAssertionProtocolValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	assertionType, ok := req.ConfigValue.Attributes()["type"].(basetypes.StringValue)
+	if !ok {
+		return
+	}
+	conflicting, ok := assertionConflictingProtocols[assertionType.ValueString()]
+	if !ok {
+		return
+	}
+
+	var protocol types.String
+	diags := req.Config.GetAttribute(ctx, path.Root("protocol"), &protocol)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || protocol.IsNull() || protocol.IsUnknown() {
+		return
+	}
+
+	if slices.Contains(conflicting, protocol.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("type"),
+			"Unsupported Assertion Type",
+			fmt.Sprintf("%s assertions can't be used by %s monitors.", assertionType.ValueString(), protocol.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSuccessAssertionPropertyValidator(t *testing.T) {
+	tests := []struct {
+		name          string
+		assertionType string
+		property      types.String
+		wantError     bool
+	}{
+		{
+			name:          "valid header name",
+			assertionType: "response_header",
+			property:      types.StringValue("Content-Type"),
+		},
+		{
+			name:          "header name with space",
+			assertionType: "response_header",
+			property:      types.StringValue("Content Type"),
+			wantError:     true,
+		},
+		{
+			name:          "missing header name",
+			assertionType: "response_header",
+			property:      types.StringNull(),
+			wantError:     true,
+		},
+		{
+			name:          "unknown header name",
+			assertionType: "response_header",
+			property:      types.StringUnknown(),
+		},
+		{
+			name:          "status code without property",
+			assertionType: "status_code",
+			property:      types.StringNull(),
+		},
+		{
+			name:          "status code with property",
+			assertionType: "status_code",
+			property:      types.StringValue("Content-Type"),
+			wantError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := types.ObjectValueMust(successAssertionAttrTypes(), map[string]attr.Value{
+				"type":     types.StringValue(tt.assertionType),
+				"operator": types.StringNull(),
+				"value":    types.StringNull(),
+				"property": tt.property,
+			})
+
+			req := validator.ObjectRequest{
+				Path:        path.Root("success_assertions").AtListIndex(0),
+				ConfigValue: value,
+			}
+			resp := &validator.ObjectResponse{}
+
+			successAssertionPropertyValidator{}.ValidateObject(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ValidateObject() has error = %v, want %v: %v", resp.Diagnostics.HasError(), tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSuccessAssertionNumericValidator(t *testing.T) {
+	tests := []struct {
+		name          string
+		assertionType string
+		operator      types.String
+		value         types.String
+		wantErrors    int
+	}{
+		{
+			name:          "less than milliseconds",
+			assertionType: "response_time",
+			operator:      types.StringValue("less_than"),
+			value:         types.StringValue("500"),
+		},
+		{
+			name:          "default operator",
+			assertionType: "response_time",
+			operator:      types.StringNull(),
+			value:         types.StringValue("500"),
+		},
+		{
+			name:          "unsupported operator",
+			assertionType: "response_time",
+			operator:      types.StringValue("contains"),
+			value:         types.StringValue("500"),
+			wantErrors:    1,
+		},
+		{
+			name:          "duration value",
+			assertionType: "response_time",
+			operator:      types.StringValue("greater_than"),
+			value:         types.StringValue("2s"),
+			wantErrors:    1,
+		},
+		{
+			name:          "missing value",
+			assertionType: "response_time",
+			operator:      types.StringValue("less_than"),
+			value:         types.StringNull(),
+			wantErrors:    1,
+		},
+		{
+			name:          "unknown value",
+			assertionType: "response_time",
+			operator:      types.StringUnknown(),
+			value:         types.StringUnknown(),
+		},
+		{
+			name:          "certificate expiry days",
+			assertionType: "certificate_expiry_days",
+			operator:      types.StringValue("less_than"),
+			value:         types.StringValue("14"),
+		},
+		{
+			name:          "certificate expiry greater than",
+			assertionType: "certificate_expiry_days",
+			operator:      types.StringValue("greater_than"),
+			value:         types.StringValue("14"),
+			wantErrors:    1,
+		},
+		{
+			name:          "certificate expiry without days",
+			assertionType: "certificate_expiry_days",
+			operator:      types.StringNull(),
+			value:         types.StringValue("0"),
+			wantErrors:    1,
+		},
+		{
+			name:          "other assertion type",
+			assertionType: "response_body",
+			operator:      types.StringValue("contains"),
+			value:         types.StringValue("Welcome"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := types.ObjectValueMust(successAssertionAttrTypes(), map[string]attr.Value{
+				"type":     types.StringValue(tt.assertionType),
+				"operator": tt.operator,
+				"value":    tt.value,
+				"property": types.StringNull(),
+			})
+
+			req := validator.ObjectRequest{
+				Path:        path.Root("success_assertions").AtListIndex(0),
+				ConfigValue: value,
+			}
+			resp := &validator.ObjectResponse{}
+
+			successAssertionNumericValidator{}.ValidateObject(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Errorf("ErrorsCount() = %d, want %d: %v", got, tt.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSuccessAssertionProtocolValidator(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name          string
+		protocol      types.String
+		assertionType string
+		wantError     bool
+	}{
+		{
+			name:          "certificate expiry on http",
+			protocol:      types.StringValue("http"),
+			assertionType: "certificate_expiry_days",
+		},
+		{
+			name:          "certificate expiry on tcp",
+			protocol:      types.StringValue("tcp"),
+			assertionType: "certificate_expiry_days",
+			wantError:     true,
+		},
+		{
+			name:          "certificate expiry on dns",
+			protocol:      types.StringValue("dns"),
+			assertionType: "certificate_expiry_days",
+			wantError:     true,
+		},
+		{
+			name:          "certificate expiry with unknown protocol",
+			protocol:      types.StringUnknown(),
+			assertionType: "certificate_expiry_days",
+		},
+		{
+			name:          "response time on tcp",
+			protocol:      types.StringValue("tcp"),
+			assertionType: "response_time",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			state := tfsdk.State(config)
+			diags := state.SetAttribute(ctx, path.Root("protocol"), tt.protocol)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			config.Raw = state.Raw
+
+			value := types.ObjectValueMust(successAssertionAttrTypes(), map[string]attr.Value{
+				"type":     types.StringValue(tt.assertionType),
+				"operator": types.StringValue("less_than"),
+				"value":    types.StringValue("14"),
+				"property": types.StringNull(),
+			})
+
+			req := validator.ObjectRequest{
+				Path:        path.Root("success_assertions").AtListIndex(0),
+				ConfigValue: value,
+				Config:      config,
+			}
+			resp := &validator.ObjectResponse{}
+
+			successAssertionProtocolValidator{}.ValidateObject(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ValidateObject() has error = %v, want %v: %v", resp.Diagnostics.HasError(), tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
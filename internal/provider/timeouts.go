@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// TimeoutsModel describes the timeouts of a resource operation.
+type TimeoutsModel struct {
+	Delete types.String `tfsdk:"delete"`
+}
+
+func timeoutsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"delete": types.StringType,
+	}
+}
+
+// deleteTimeoutsAttribute returns the timeouts attribute of resources whose
+// delete waits for the API, such as when verify_delete is enabled
+func deleteTimeoutsAttribute(defaultDelete time.Duration) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Timeouts of the operations on the resource",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"delete": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long a delete may take, as a duration such as `30s` or `10m`. "+
+					"Bounds the wait for the deletion to be confirmed when `verify_delete` is enabled. Defaults to `%s`", formatTimeout(defaultDelete)),
+				Optional: true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+		},
+	}
+}
+
+// deleteTimeout returns the delete timeout set in timeouts, or defaultDelete
+// when it is unset
+func deleteTimeout(ctx context.Context, timeouts types.Object, defaultDelete time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if timeouts.IsNull() || timeouts.IsUnknown() {
+		return defaultDelete, diags
+	}
+
+	var data TimeoutsModel
+	diags.Append(timeouts.As(ctx, &data, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || data.Delete.IsNull() || data.Delete.IsUnknown() {
+		return defaultDelete, diags
+	}
+
+	timeout, err := time.ParseDuration(data.Delete.ValueString())
+	if err != nil {
+		diags.AddError("Invalid Delete Timeout", fmt.Sprintf("Failed to parse timeouts.delete: %s", err.Error()))
+		return defaultDelete, diags
+	}
+
+	return timeout, diags
+}
+
+// formatTimeout formats whole minutes as `5m` rather than `5m0s`
+func formatTimeout(d time.Duration) string {
+	if d%time.Minute == 0 {
+		return fmt.Sprintf("%dm", d/time.Minute)
+	}
+	return d.String()
+}
+
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that a string is a positive duration such as
+// `30s` or `10m`.
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration such as 30s or 10m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a positive duration such as `30s` or `10m`"
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err == nil && d > 0 {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Duration",
+		fmt.Sprintf("Expected a positive duration such as 30s or 10m, got: %q", req.ConfigValue.ValueString()),
+	)
+}
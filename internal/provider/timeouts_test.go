@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDeleteTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeouts types.Object
+		want     time.Duration
+	}{
+		{
+			name:     "timeouts unset",
+			timeouts: types.ObjectNull(timeoutsAttrTypes()),
+			want:     5 * time.Minute,
+		},
+		{
+			name:     "delete unset",
+			timeouts: types.ObjectValueMust(timeoutsAttrTypes(), map[string]attr.Value{"delete": types.StringNull()}),
+			want:     5 * time.Minute,
+		},
+		{
+			name:     "delete set",
+			timeouts: types.ObjectValueMust(timeoutsAttrTypes(), map[string]attr.Value{"delete": types.StringValue("90s")}),
+			want:     90 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := deleteTimeout(context.Background(), tt.timeouts, 5*time.Minute)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("deleteTimeout() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{
+			name:  "minutes",
+			value: types.StringValue("10m"),
+		},
+		{
+			name:  "combined units",
+			value: types.StringValue("1h30m"),
+		},
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:  "unknown",
+			value: types.StringUnknown(),
+		},
+		{
+			name:      "missing unit",
+			value:     types.StringValue("300"),
+			wantError: true,
+		},
+		{
+			name:      "zero",
+			value:     types.StringValue("0s"),
+			wantError: true,
+		},
+		{
+			name:      "negative",
+			value:     types.StringValue("-5m"),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("timeouts").AtName("delete"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+
+			durationValidator{}.ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Errorf("HasError() = %v, want %v: %v", got, tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
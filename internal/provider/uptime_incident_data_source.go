@@ -174,12 +174,8 @@ func (d *UptimeIncidentDataSource) Read(ctx context.Context, req datasource.Read
 		data.RecoveryAt = types.StringNull()
 	}
 
-	if incident.CreatedAt != nil {
-		data.CreatedAt = types.StringValue(*incident.CreatedAt)
-	}
-	if incident.UpdatedAt != nil {
-		data.UpdatedAt = types.StringValue(*incident.UpdatedAt)
-	}
+	data.CreatedAt = timestampValue(incident.CreatedAt, data.CreatedAt)
+	data.UpdatedAt = timestampValue(incident.UpdatedAt, data.UpdatedAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
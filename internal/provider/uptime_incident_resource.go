@@ -0,0 +1,653 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UptimeIncidentResource{}
+var _ resource.ResourceWithImportState = &UptimeIncidentResource{}
+var _ resource.ResourceWithModifyPlan = &UptimeIncidentResource{}
+var _ resource.ResourceWithConfigValidators = &UptimeIncidentResource{}
+
+// incidentImpacts lists the impact levels of an incident
+var incidentImpacts = []string{"degradedPerformance", "partialOutage", "majorOutage", "maintenance"}
+
+// incidentStatusPageColors maps each incident impact to the status page
+// colors entry used to display it
+var incidentStatusPageColors = map[string]string{
+	"degradedPerformance": "degraded_performance",
+	"partialOutage":       "partial_outage",
+	"majorOutage":         "major_outage",
+	"maintenance":         "maintenance",
+}
+
+// incidentStates lists the states of an incident
+var incidentStates = []string{"investigating", "identified", "monitoring", "resolved"}
+
+// incidentStatuses lists the statuses of an incident
+var incidentStatuses = []string{"ongoing", "resolved"}
+
+func NewUptimeIncidentResource() resource.Resource {
+	return &UptimeIncidentResource{}
+}
+
+// UptimeIncidentResource defines the resource implementation.
+type UptimeIncidentResource struct {
+	client *client.Client
+}
+
+// UptimeIncidentResourceModel describes the resource data model.
+type UptimeIncidentResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Title               types.String `tfsdk:"title"`
+	Impact              types.String `tfsdk:"impact"`
+	State               types.String `tfsdk:"state"`
+	Description         types.String `tfsdk:"description"`
+	ExcludeFromDowntime types.Bool   `tfsdk:"exclude_from_downtime"`
+	StatusPageColor     types.String `tfsdk:"status_page_color"`
+	MonitorIDs          types.List   `tfsdk:"monitor_ids"`
+	WarnOnMonitorStatus types.Bool   `tfsdk:"warn_on_monitor_status"`
+	IncidentAt          types.String `tfsdk:"incident_at"`
+	Updates             types.List   `tfsdk:"updates"`
+	Slug                types.String `tfsdk:"slug"`
+	Status              types.String `tfsdk:"status"`
+	RecoveryAt          types.String `tfsdk:"recovery_at"`
+	ProjectID           types.Int64  `tfsdk:"project_id"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+	BaseURL             types.String `tfsdk:"base_url"`
+}
+
+func (r *UptimeIncidentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_uptime_incident"
+}
+
+func (r *UptimeIncidentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Phare uptime incident (status page incident), for example to announce a deployment or an outage from CI.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the incident",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the incident",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"impact": schema.StringAttribute{
+				MarkdownDescription: "The impact level of the incident: `degradedPerformance`, `partialOutage`, `majorOutage`, or `maintenance`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(incidentImpacts...),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The current state of the incident: `investigating`, `identified`, `monitoring`, or `resolved`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(incidentStates...),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the incident",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"exclude_from_downtime": schema.BoolAttribute{
+				MarkdownDescription: "Whether this incident is excluded from downtime calculations, defaults to `true` for `maintenance` incidents and `false` otherwise",
+				Optional:            true,
+				Computed:            true,
+			},
+			"status_page_color": schema.StringAttribute{
+				MarkdownDescription: "Entry of the `colors` of attached status pages used to display the incident: `degraded_performance`, `partial_outage`, `major_outage`, or `maintenance`",
+				Computed:            true,
+			},
+			"monitor_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the monitors affected by the incident",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"warn_on_monitor_status": schema.BoolAttribute{
+				MarkdownDescription: "Whether to warn on create and update when the impact is `partialOutage` or `majorOutage` while all `monitor_ids` are up. " +
+					"The check never fails the apply. Defaults to `false`",
+				Optional: true,
+			},
+			"incident_at": schema.StringAttribute{
+				MarkdownDescription: "RFC3339 timestamp when the incident occurred, defaults to the time of creation",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updates": schema.ListNestedAttribute{
+				MarkdownDescription: "Timeline of the incident, oldest first. Entries are matched by position: changing an entry edits it, " +
+					"removing entries from the end deletes them. When unset, the timeline isn't managed and isn't restored on import",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The unique identifier of the timeline entry",
+							Computed:            true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "State of the incident announced by the entry: `investigating`, `identified`, `monitoring`, or `resolved`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(incidentStates...),
+							},
+						},
+						"body": schema.StringAttribute{
+							MarkdownDescription: "Markdown text of the entry",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"published_at": schema.StringAttribute{
+							MarkdownDescription: "RFC3339 timestamp when the entry was published, defaults to the time it is posted",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								rfc3339Validator{},
+							},
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "The URL-friendly slug for the incident",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the incident: `ongoing` or `resolved`. When unset, Phare derives it from `state`",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(incidentStatuses...),
+				},
+			},
+			"recovery_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the incident was recovered (if resolved)",
+				Computed:            true,
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the project this incident belongs to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the incident was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the incident was last updated",
+				Computed:            true,
+			},
+			"base_url": baseURLAttribute(),
+		},
+	}
+}
+
+func (r *UptimeIncidentResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		maintenanceDowntimeValidator{},
+	}
+}
+
+func (r *UptimeIncidentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var impact types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("impact"), &impact)...)
+	if resp.Diagnostics.HasError() || impact.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status_page_color"), incidentStatusPageColorValue(impact.ValueString()))...)
+
+	// Maintenance is excluded from downtime unless configured otherwise, a
+	// configured value can't be changed in the plan
+	var excludeFromDowntime types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("exclude_from_downtime"), &excludeFromDowntime)...)
+	if resp.Diagnostics.HasError() || !excludeFromDowntime.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("exclude_from_downtime"), impact.ValueString() == "maintenance")...)
+}
+
+func (r *UptimeIncidentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UptimeIncidentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UptimeIncidentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An incident without an explicit start begins now
+	if data.IncidentAt.IsUnknown() || data.IncidentAt.IsNull() {
+		data.IncidentAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	}
+
+	tflog.Debug(ctx, "Creating uptime incident", map[string]any{"title": data.Title.ValueString()})
+
+	incident, diags := r.terraformToAPIModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api := scopedClient(r.client, data.BaseURL)
+	if data.WarnOnMonitorStatus.ValueBool() {
+		resp.Diagnostics.Append(checkIncidentMonitorStatus(ctx, api, incident.Impact, incident.MonitorIDs)...)
+	}
+
+	created, err := api.CreateIncident(ctx, incident)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create incident", err.Error())
+		return
+	}
+
+	if created.ID == nil {
+		resp.Diagnostics.AddError("Failed to create incident", "API did not return an incident ID")
+		return
+	}
+
+	if !data.Updates.IsNull() {
+		var planned []IncidentUpdateModel
+		resp.Diagnostics.Append(data.Updates.ElementsAs(ctx, &planned, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := syncIncidentUpdates(ctx, api, *created.ID, planned, nil); err != nil {
+			resp.Diagnostics.AddError("Failed to create incident updates", err.Error())
+			return
+		}
+	}
+
+	// Read back the incident to get all fields
+	incident, err = api.GetIncident(ctx, *created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read created incident", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiToTerraformModel(ctx, incident, &data)...)
+
+	resp.Diagnostics.Append(r.readUpdates(ctx, api, *created.ID, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UptimeIncidentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UptimeIncidentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading uptime incident", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid incident ID", fmt.Sprintf("Failed to parse incident ID: %s", err.Error()))
+		return
+	}
+
+	api := scopedClient(r.client, data.BaseURL)
+	incident, err := api.GetIncident(ctx, id)
+	if client.IsNotFound(err) {
+		// Deleted outside Terraform, remove it so that it's planned for creation
+		tflog.Warn(ctx, "Incident not found, removing from state", map[string]any{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read incident", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiToTerraformModel(ctx, incident, &data)...)
+	resp.Diagnostics.Append(r.readUpdates(ctx, api, id, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UptimeIncidentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UptimeIncidentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating uptime incident", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid incident ID", fmt.Sprintf("Failed to parse incident ID: %s", err.Error()))
+		return
+	}
+
+	incident, diags := r.terraformToAPIModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api := scopedClient(r.client, data.BaseURL)
+	if data.WarnOnMonitorStatus.ValueBool() {
+		resp.Diagnostics.Append(checkIncidentMonitorStatus(ctx, api, incident.Impact, incident.MonitorIDs)...)
+	}
+
+	updated, err := api.UpdateIncident(ctx, id, incident)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update incident", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiToTerraformModel(ctx, updated, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Updates.IsNull() {
+		var planned, prior []IncidentUpdateModel
+		resp.Diagnostics.Append(data.Updates.ElementsAs(ctx, &planned, false)...)
+
+		var priorUpdates types.List
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("updates"), &priorUpdates)...)
+		if !priorUpdates.IsNull() {
+			resp.Diagnostics.Append(priorUpdates.ElementsAs(ctx, &prior, false)...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := syncIncidentUpdates(ctx, api, id, planned, prior); err != nil {
+			resp.Diagnostics.AddError("Failed to update incident updates", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.readUpdates(ctx, api, id, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UptimeIncidentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UptimeIncidentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting uptime incident", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid incident ID", fmt.Sprintf("Failed to parse incident ID: %s", err.Error()))
+		return
+	}
+
+	if err := scopedClient(r.client, data.BaseURL).DeleteIncident(ctx, id); err != nil {
+		resp.Diagnostics.AddError("Failed to delete incident", err.Error())
+		return
+	}
+}
+
+func (r *UptimeIncidentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// terraformToAPIModel converts Terraform model to API client model
+func (r *UptimeIncidentResource) terraformToAPIModel(ctx context.Context, data *UptimeIncidentResourceModel) (*client.Incident, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// An unset status is planned as unknown and left out of the request, so
+	// that Phare derives it from the state
+	incident := &client.Incident{
+		Title:               data.Title.ValueString(),
+		Impact:              data.Impact.ValueString(),
+		State:               data.State.ValueString(),
+		Description:         data.Description.ValueString(),
+		ExcludeFromDowntime: data.ExcludeFromDowntime.ValueBool(),
+		Status:              data.Status.ValueString(),
+		IncidentAt:          data.IncidentAt.ValueString(),
+		MonitorIDs:          []int{},
+	}
+
+	if !data.MonitorIDs.IsNull() {
+		var monitorIDs []int64
+		diags.Append(data.MonitorIDs.ElementsAs(ctx, &monitorIDs, false)...)
+
+		incident.MonitorIDs = make([]int, len(monitorIDs))
+		for i, id := range monitorIDs {
+			incident.MonitorIDs[i] = int(id)
+		}
+	}
+
+	return incident, diags
+}
+
+// apiToTerraformModel converts API client model to Terraform model
+func (r *UptimeIncidentResource) apiToTerraformModel(ctx context.Context, incident *client.Incident, data *UptimeIncidentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if incident.ID != nil {
+		data.ID = types.StringValue(strconv.Itoa(*incident.ID))
+	}
+
+	data.Title = types.StringValue(incident.Title)
+	data.Impact = types.StringValue(incident.Impact)
+	data.State = types.StringValue(incident.State)
+	data.Description = types.StringValue(incident.Description)
+	data.ExcludeFromDowntime = types.BoolValue(incident.ExcludeFromDowntime)
+	data.StatusPageColor = incidentStatusPageColorValue(incident.Impact)
+	data.IncidentAt = sameInstantValue(incident.IncidentAt, data.IncidentAt)
+	data.Slug = types.StringValue(incident.Slug)
+	data.Status = types.StringValue(incident.Status)
+
+	if incident.RecoveryAt != nil {
+		data.RecoveryAt = types.StringValue(*incident.RecoveryAt)
+	} else {
+		data.RecoveryAt = types.StringNull()
+	}
+
+	data.ProjectID = int64PointerValue(incident.ProjectID)
+	data.CreatedAt = timestampValue(incident.CreatedAt, data.CreatedAt)
+	data.UpdatedAt = timestampValue(incident.UpdatedAt, data.UpdatedAt)
+
+	// An incident without monitors keeps monitor_ids unset
+	if len(incident.MonitorIDs) == 0 && (data.MonitorIDs.IsNull() || data.MonitorIDs.IsUnknown()) {
+		data.MonitorIDs = types.ListNull(types.Int64Type)
+		return diags
+	}
+
+	monitorIDs := make([]int64, len(incident.MonitorIDs))
+	for i, id := range incident.MonitorIDs {
+		monitorIDs[i] = int64(id)
+	}
+	monitorList, diagList := types.ListValueFrom(ctx, types.Int64Type, monitorIDs)
+	diags.Append(diagList...)
+	data.MonitorIDs = monitorList
+
+	return diags
+}
+
+// checkIncidentMonitorStatus warns when an outage is declared while all the
+// monitors of the incident are up. It only returns warnings, monitors that
+// can't be read are reported and skipped.
+func checkIncidentMonitorStatus(ctx context.Context, api client.MonitorAPI, impact string, monitorIDs []int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if (impact != "partialOutage" && impact != "majorOutage") || len(monitorIDs) == 0 {
+		return diags
+	}
+
+	for _, id := range monitorIDs {
+		monitor, err := api.GetMonitor(ctx, id)
+		if err != nil {
+			diags.AddAttributeWarning(
+				path.Root("monitor_ids"),
+				"Monitor Status Not Checked",
+				fmt.Sprintf("Could not read monitor %d to compare its status with the incident impact: %s", id, err.Error()),
+			)
+			return diags
+		}
+
+		if monitor.Status == nil || *monitor.Status != "up" {
+			return diags
+		}
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("impact"),
+		"Incident Impact Does Not Match Monitor Status",
+		fmt.Sprintf("The incident impact is %q but all its monitors are up. "+
+			"Check the impact, or set warn_on_monitor_status to false to disable this check.", impact),
+	)
+
+	return diags
+}
+
+// readUpdates refreshes the incident timeline in data. The timeline is only
+// read when it is managed, i.e. when updates is set.
+func (r *UptimeIncidentResource) readUpdates(ctx context.Context, api incidentUpdatesAPI, id int, data *UptimeIncidentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Updates.IsNull() {
+		return diags
+	}
+
+	var prior []IncidentUpdateModel
+	if !data.Updates.IsUnknown() {
+		diags.Append(data.Updates.ElementsAs(ctx, &prior, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	updates, err := api.ListIncidentUpdates(ctx, id)
+	if err != nil {
+		diags.AddError("Failed to read incident updates", err.Error())
+		return diags
+	}
+
+	list, d := incidentUpdatesValue(updates, prior)
+	diags.Append(d...)
+	data.Updates = list
+
+	return diags
+}
+
+// incidentStatusPageColorValue returns the status page colors entry of an
+// impact, or null when the impact is unknown to the provider
+func incidentStatusPageColorValue(impact string) types.String {
+	color, ok := incidentStatusPageColors[impact]
+	if !ok {
+		return types.StringNull()
+	}
+	return types.StringValue(color)
+}
+
+// sameInstantValue returns the prior timestamp when the API returned the same
+// instant in another format, so that the configured value doesn't show a diff
+func sameInstantValue(ts string, prior types.String) types.String {
+	if prior.IsNull() || prior.IsUnknown() {
+		return types.StringValue(ts)
+	}
+
+	got, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		return types.StringValue(ts)
+	}
+	want, err := time.Parse(time.RFC3339, prior.ValueString())
+	if err != nil || !got.Equal(want) {
+		return types.StringValue(ts)
+	}
+
+	return prior
+}
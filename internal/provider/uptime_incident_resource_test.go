@@ -0,0 +1,409 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+func TestAccUptimeIncidentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUptimeIncidentResourceConfig("investigating"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_incident.test",
+						tfjsonpath.New("state"),
+						knownvalue.StringExact("investigating"),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_uptime_incident.test",
+						tfjsonpath.New("incident_at"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_uptime_incident.test",
+						tfjsonpath.New("slug"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "phare_uptime_incident.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccUptimeIncidentResourceConfig("resolved"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_incident.test",
+						tfjsonpath.New("state"),
+						knownvalue.StringExact("resolved"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccUptimeIncidentResource_Updates(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUptimeIncidentResourceConfig_Updates(false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_incident.test",
+						tfjsonpath.New("updates"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_uptime_incident.test",
+						tfjsonpath.New("updates").AtSliceIndex(0).AtMapKey("published_at"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// Appending an entry posts it on the timeline
+			{
+				Config: testAccUptimeIncidentResourceConfig_Updates(true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_incident.test",
+						tfjsonpath.New("updates").AtSliceIndex(1).AtMapKey("state"),
+						knownvalue.StringExact("resolved"),
+					),
+				},
+			},
+			// Removing the last entry deletes it
+			{
+				Config: testAccUptimeIncidentResourceConfig_Updates(false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_incident.test",
+						tfjsonpath.New("updates"),
+						knownvalue.ListSizeExact(1),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccUptimeIncidentResourceConfig(state string) string {
+	return fmt.Sprintf(`
+resource "phare_uptime_incident" "test" {
+  title                 = "TF Test Incident"
+  impact                = "degradedPerformance"
+  state                 = %[1]q
+  description           = "Created by the provider acceptance tests"
+  exclude_from_downtime = true
+}
+`, state)
+}
+
+func TestSameInstantValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		ts    string
+		prior types.String
+		want  string
+	}{
+		{
+			name:  "no prior value",
+			ts:    "2024-01-01T12:00:00.000000Z",
+			prior: types.StringNull(),
+			want:  "2024-01-01T12:00:00.000000Z",
+		},
+		{
+			name:  "same instant in another format",
+			ts:    "2024-01-01T12:00:00.000000Z",
+			prior: types.StringValue("2024-01-01T14:00:00+02:00"),
+			want:  "2024-01-01T14:00:00+02:00",
+		},
+		{
+			name:  "changed instant",
+			ts:    "2024-01-01T13:00:00Z",
+			prior: types.StringValue("2024-01-01T12:00:00Z"),
+			want:  "2024-01-01T13:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameInstantValue(tt.ts, tt.prior); got.ValueString() != tt.want {
+				t.Errorf("sameInstantValue() = %s, want %s", got.ValueString(), tt.want)
+			}
+		})
+	}
+}
+
+func TestUptimeIncidentStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		status types.String
+		want   string
+	}{
+		{
+			name:   "configured status is sent",
+			status: types.StringValue("resolved"),
+			want:   "resolved",
+		},
+		{
+			name:   "unset status is left to Phare",
+			status: types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &UptimeIncidentResource{}
+			incident, diags := r.terraformToAPIModel(context.Background(), &UptimeIncidentResourceModel{
+				Title:  types.StringValue("Outage"),
+				Impact: types.StringValue("majorOutage"),
+				State:  types.StringValue("monitoring"),
+				Status: tt.status,
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if incident.Status != tt.want {
+				t.Errorf("status = %q, want %q", incident.Status, tt.want)
+			}
+		})
+	}
+}
+
+func TestUptimeIncidentResourceModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeIncidentResource{}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name                    string
+		impact                  types.String
+		excludeFromDowntime     types.Bool
+		wantExcludeFromDowntime types.Bool
+		wantStatusPageColor     types.String
+	}{
+		{
+			name:                    "maintenance is excluded from downtime",
+			impact:                  types.StringValue("maintenance"),
+			excludeFromDowntime:     types.BoolNull(),
+			wantExcludeFromDowntime: types.BoolValue(true),
+			wantStatusPageColor:     types.StringValue("maintenance"),
+		},
+		{
+			name:                    "outages count as downtime",
+			impact:                  types.StringValue("majorOutage"),
+			excludeFromDowntime:     types.BoolNull(),
+			wantExcludeFromDowntime: types.BoolValue(false),
+			wantStatusPageColor:     types.StringValue("major_outage"),
+		},
+		{
+			name:                    "configured value is kept for maintenance",
+			impact:                  types.StringValue("maintenance"),
+			excludeFromDowntime:     types.BoolValue(false),
+			wantExcludeFromDowntime: types.BoolValue(false),
+			wantStatusPageColor:     types.StringValue("maintenance"),
+		},
+		{
+			name:                    "configured value is kept for outages",
+			impact:                  types.StringValue("partialOutage"),
+			excludeFromDowntime:     types.BoolValue(true),
+			wantExcludeFromDowntime: types.BoolValue(true),
+			wantStatusPageColor:     types.StringValue("partial_outage"),
+		},
+		{
+			name:                    "unknown impact",
+			impact:                  types.StringUnknown(),
+			excludeFromDowntime:     types.BoolNull(),
+			wantExcludeFromDowntime: types.BoolNull(),
+			wantStatusPageColor:     types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			state := tfsdk.State(config)
+			diags := state.SetAttribute(ctx, path.Root("title"), "Database upgrade")
+			diags.Append(state.SetAttribute(ctx, path.Root("impact"), tt.impact)...)
+			diags.Append(state.SetAttribute(ctx, path.Root("state"), "identified")...)
+			diags.Append(state.SetAttribute(ctx, path.Root("exclude_from_downtime"), tt.excludeFromDowntime)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			config.Raw = state.Raw
+
+			plan := tfsdk.Plan(state)
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Config: config, Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var excludeFromDowntime types.Bool
+			var statusPageColor types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("exclude_from_downtime"), &excludeFromDowntime)...)
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("status_page_color"), &statusPageColor)...)
+			if !excludeFromDowntime.Equal(tt.wantExcludeFromDowntime) {
+				t.Errorf("exclude_from_downtime = %v, want %v", excludeFromDowntime, tt.wantExcludeFromDowntime)
+			}
+			if !statusPageColor.Equal(tt.wantStatusPageColor) {
+				t.Errorf("status_page_color = %v, want %v", statusPageColor, tt.wantStatusPageColor)
+			}
+		})
+	}
+}
+
+func TestIncidentStatusPageColors(t *testing.T) {
+	for _, impact := range incidentImpacts {
+		if incidentStatusPageColorValue(impact).IsNull() {
+			t.Errorf("impact %q has no status page color", impact)
+		}
+	}
+}
+
+func TestCheckIncidentMonitorStatus(t *testing.T) {
+	ctx := context.Background()
+
+	api := newFakeMonitorAPI()
+	for _, status := range []string{"up", "up", "down"} {
+		if _, err := api.CreateMonitor(ctx, &client.Monitor{Name: "API", Status: stringPtr(status)}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		impact      string
+		monitorIDs  []int
+		wantWarning bool
+		wantPath    path.Path
+		wantCalls   int
+	}{
+		{
+			name:        "major outage with all monitors up",
+			impact:      "majorOutage",
+			monitorIDs:  []int{1, 2},
+			wantWarning: true,
+			wantPath:    path.Root("impact"),
+			wantCalls:   2,
+		},
+		{
+			name:        "partial outage with all monitors up",
+			impact:      "partialOutage",
+			monitorIDs:  []int{1},
+			wantWarning: true,
+			wantPath:    path.Root("impact"),
+			wantCalls:   1,
+		},
+		{
+			name:       "major outage with a monitor down",
+			impact:     "majorOutage",
+			monitorIDs: []int{1, 3, 2},
+			wantCalls:  2,
+		},
+		{
+			name:       "degraded performance with all monitors up",
+			impact:     "degradedPerformance",
+			monitorIDs: []int{1, 2},
+		},
+		{
+			name:   "major outage without monitors",
+			impact: "majorOutage",
+		},
+		{
+			name:        "unreadable monitor",
+			impact:      "majorOutage",
+			monitorIDs:  []int{1, 42},
+			wantWarning: true,
+			wantPath:    path.Root("monitor_ids"),
+			wantCalls:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api.calls = nil
+
+			diags := checkIncidentMonitorStatus(ctx, api, tt.impact, tt.monitorIDs)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			if len(api.calls) != tt.wantCalls {
+				t.Errorf("calls = %v, want %d calls", api.calls, tt.wantCalls)
+			}
+
+			warnings := diags.Warnings()
+			if !tt.wantWarning {
+				if len(warnings) != 0 {
+					t.Errorf("unexpected warnings: %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 {
+				t.Fatalf("got %d warnings, want 1: %v", len(warnings), warnings)
+			}
+			withPath, ok := warnings[0].(interface{ Path() path.Path })
+			if !ok || !withPath.Path().Equal(tt.wantPath) {
+				t.Errorf("warning is not attached to %s: %v", tt.wantPath, warnings[0])
+			}
+		})
+	}
+}
+
+func testAccUptimeIncidentResourceConfig_Updates(resolved bool) string {
+	resolution := ""
+	if resolved {
+		resolution = `,
+    {
+      state = "resolved"
+      body  = "The API is responding normally again."
+    }`
+	}
+
+	return fmt.Sprintf(`
+resource "phare_uptime_incident" "test" {
+  title  = "TF Test Incident Timeline"
+  impact = "degradedPerformance"
+  state  = "investigating"
+
+  updates = [
+    {
+      state = "investigating"
+      body  = "We are investigating slow API responses."
+    }%[1]s
+  ]
+}
+`, resolution)
+}
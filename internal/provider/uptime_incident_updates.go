@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// IncidentUpdateModel describes an entry of the incident timeline.
+type IncidentUpdateModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	State       types.String `tfsdk:"state"`
+	Body        types.String `tfsdk:"body"`
+	PublishedAt types.String `tfsdk:"published_at"`
+}
+
+func incidentUpdateAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.Int64Type,
+		"state":        types.StringType,
+		"body":         types.StringType,
+		"published_at": types.StringType,
+	}
+}
+
+// incidentUpdatesAPI is the set of client operations used to manage the
+// timeline of an incident
+type incidentUpdatesAPI interface {
+	ListIncidentUpdates(ctx context.Context, incidentID int) ([]client.IncidentUpdate, error)
+	CreateIncidentUpdate(ctx context.Context, incidentID int, update *client.IncidentUpdate) (*client.IncidentUpdate, error)
+	UpdateIncidentUpdate(ctx context.Context, incidentID, id int, update *client.IncidentUpdate) (*client.IncidentUpdate, error)
+	DeleteIncidentUpdate(ctx context.Context, incidentID, id int) error
+}
+
+// syncIncidentUpdates reconciles the timeline of an incident with the planned
+// entries. Entries are matched by position: changed entries are updated, extra
+// planned entries are posted and entries beyond the planned ones are deleted.
+func syncIncidentUpdates(ctx context.Context, api incidentUpdatesAPI, incidentID int, planned, prior []IncidentUpdateModel) error {
+	for i, update := range planned {
+		if i >= len(prior) {
+			if _, err := api.CreateIncidentUpdate(ctx, incidentID, incidentUpdateToAPI(update)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !incidentUpdateChanged(update, prior[i]) {
+			continue
+		}
+		if prior[i].ID.IsNull() || prior[i].ID.IsUnknown() {
+			return fmt.Errorf("timeline entry %d has no ID", i)
+		}
+		if _, err := api.UpdateIncidentUpdate(ctx, incidentID, int(prior[i].ID.ValueInt64()), incidentUpdateToAPI(update)); err != nil {
+			return err
+		}
+	}
+
+	for i := len(planned); i < len(prior); i++ {
+		if prior[i].ID.IsNull() || prior[i].ID.IsUnknown() {
+			continue
+		}
+		err := api.DeleteIncidentUpdate(ctx, incidentID, int(prior[i].ID.ValueInt64()))
+		if err != nil && !client.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// incidentUpdateChanged reports whether a planned timeline entry differs from
+// its prior value. An unknown published_at keeps the published time.
+func incidentUpdateChanged(planned, prior IncidentUpdateModel) bool {
+	if !planned.State.Equal(prior.State) || !planned.Body.Equal(prior.Body) {
+		return true
+	}
+	if planned.PublishedAt.IsUnknown() || planned.PublishedAt.IsNull() {
+		return false
+	}
+	return !sameInstantValue(planned.PublishedAt.ValueString(), prior.PublishedAt).Equal(prior.PublishedAt)
+}
+
+// incidentUpdateToAPI converts a timeline entry to the API client model
+func incidentUpdateToAPI(update IncidentUpdateModel) *client.IncidentUpdate {
+	apiUpdate := &client.IncidentUpdate{
+		State: update.State.ValueString(),
+		Body:  update.Body.ValueString(),
+	}
+	if !update.PublishedAt.IsUnknown() && !update.PublishedAt.IsNull() {
+		apiUpdate.PublishedAt = stringPtr(update.PublishedAt.ValueString())
+	}
+	return apiUpdate
+}
+
+// incidentUpdatesValue converts the timeline returned by the API to the
+// updates list, keeping the prior published_at of entries published at the
+// same instant
+func incidentUpdatesValue(updates []client.IncidentUpdate, prior []IncidentUpdateModel) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elements := make([]attr.Value, len(updates))
+	for i, update := range updates {
+		priorPublishedAt := types.StringNull()
+		if i < len(prior) {
+			priorPublishedAt = prior[i].PublishedAt
+		}
+
+		publishedAt := types.StringNull()
+		if update.PublishedAt != nil {
+			publishedAt = sameInstantValue(*update.PublishedAt, priorPublishedAt)
+		}
+
+		element, d := types.ObjectValue(incidentUpdateAttrTypes(), map[string]attr.Value{
+			"id":           int64PointerValue(update.ID),
+			"state":        types.StringValue(update.State),
+			"body":         types.StringValue(update.Body),
+			"published_at": publishedAt,
+		})
+		diags.Append(d...)
+		elements[i] = element
+	}
+
+	list, d := types.ListValue(types.ObjectType{AttrTypes: incidentUpdateAttrTypes()}, elements)
+	diags.Append(d...)
+
+	return list, diags
+}
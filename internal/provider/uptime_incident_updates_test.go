@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// fakeIncidentUpdatesAPI records the timeline calls it receives
+type fakeIncidentUpdatesAPI struct {
+	calls []string
+}
+
+func (f *fakeIncidentUpdatesAPI) ListIncidentUpdates(ctx context.Context, incidentID int) ([]client.IncidentUpdate, error) {
+	return nil, nil
+}
+
+func (f *fakeIncidentUpdatesAPI) CreateIncidentUpdate(ctx context.Context, incidentID int, update *client.IncidentUpdate) (*client.IncidentUpdate, error) {
+	f.calls = append(f.calls, fmt.Sprintf("CreateIncidentUpdate(%s)", update.State))
+	return update, nil
+}
+
+func (f *fakeIncidentUpdatesAPI) UpdateIncidentUpdate(ctx context.Context, incidentID, id int, update *client.IncidentUpdate) (*client.IncidentUpdate, error) {
+	f.calls = append(f.calls, fmt.Sprintf("UpdateIncidentUpdate(%d, %s)", id, update.State))
+	return update, nil
+}
+
+func (f *fakeIncidentUpdatesAPI) DeleteIncidentUpdate(ctx context.Context, incidentID, id int) error {
+	f.calls = append(f.calls, fmt.Sprintf("DeleteIncidentUpdate(%d)", id))
+	return nil
+}
+
+func incidentUpdateModel(id int64, state, publishedAt string) IncidentUpdateModel {
+	update := IncidentUpdateModel{
+		ID:          types.Int64Unknown(),
+		State:       types.StringValue(state),
+		Body:        types.StringValue("Body of the " + state + " entry"),
+		PublishedAt: types.StringUnknown(),
+	}
+	if id != 0 {
+		update.ID = types.Int64Value(id)
+	}
+	if publishedAt != "" {
+		update.PublishedAt = types.StringValue(publishedAt)
+	}
+	return update
+}
+
+func TestSyncIncidentUpdates(t *testing.T) {
+	prior := []IncidentUpdateModel{
+		incidentUpdateModel(1, "investigating", "2024-01-01T00:00:00Z"),
+		incidentUpdateModel(2, "identified", "2024-01-01T00:30:00Z"),
+	}
+
+	tests := []struct {
+		name      string
+		planned   []IncidentUpdateModel
+		wantCalls []string
+	}{
+		{
+			name:    "unchanged",
+			planned: prior,
+		},
+		{
+			name: "same instant in another offset",
+			planned: []IncidentUpdateModel{
+				prior[0],
+				incidentUpdateModel(2, "identified", "2024-01-01T01:30:00+01:00"),
+			},
+		},
+		{
+			name:      "appended entry",
+			planned:   append(slices.Clone(prior), incidentUpdateModel(0, "resolved", "")),
+			wantCalls: []string{"CreateIncidentUpdate(resolved)"},
+		},
+		{
+			name: "changed entry",
+			planned: []IncidentUpdateModel{
+				prior[0],
+				incidentUpdateModel(2, "monitoring", "2024-01-01T00:30:00Z"),
+			},
+			wantCalls: []string{"UpdateIncidentUpdate(2, monitoring)"},
+		},
+		{
+			name:      "removed entry",
+			planned:   prior[:1],
+			wantCalls: []string{"DeleteIncidentUpdate(2)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &fakeIncidentUpdatesAPI{}
+			if err := syncIncidentUpdates(context.Background(), api, 1, tt.planned, prior); err != nil {
+				t.Fatalf("syncIncidentUpdates() unexpected error: %v", err)
+			}
+			if !slices.Equal(api.calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", api.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestIncidentUpdatesValue(t *testing.T) {
+	id1, id2 := 1, 2
+	updates := []client.IncidentUpdate{
+		{ID: &id1, State: "investigating", Body: "Investigating", PublishedAt: stringPtr("2024-01-01T00:00:00Z")},
+		{ID: &id2, State: "resolved", Body: "Resolved", PublishedAt: stringPtr("2024-01-01T01:00:00Z")},
+	}
+	prior := []IncidentUpdateModel{
+		incidentUpdateModel(1, "investigating", "2024-01-01T01:00:00+01:00"),
+		incidentUpdateModel(0, "resolved", ""),
+	}
+
+	list, diags := incidentUpdatesValue(updates, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var got []IncidentUpdateModel
+	diags = list.ElementsAs(context.Background(), &got, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("got %d updates, want 2", len(got))
+	}
+	// The configured published_at is kept when the API returns the same instant
+	if want := "2024-01-01T01:00:00+01:00"; got[0].PublishedAt.ValueString() != want {
+		t.Errorf("updates[0].published_at = %s, want %q", got[0].PublishedAt, want)
+	}
+	if want := "2024-01-01T01:00:00Z"; got[1].PublishedAt.ValueString() != want {
+		t.Errorf("updates[1].published_at = %s, want %q", got[1].PublishedAt, want)
+	}
+	if got[1].ID.ValueInt64() != 2 {
+		t.Errorf("updates[1].id = %s, want 2", got[1].ID)
+	}
+}
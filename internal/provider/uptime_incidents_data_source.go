@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+const (
+	// defaultIncidentsLimit is the number of incidents listed when limit is unset
+	defaultIncidentsLimit = 100
+
+	// maxIncidentsLimit bounds limit so that reports can't fetch an unbounded history
+	maxIncidentsLimit = 1000
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UptimeIncidentsDataSource{}
+
+func NewUptimeIncidentsDataSource() datasource.DataSource {
+	return &UptimeIncidentsDataSource{}
+}
+
+// UptimeIncidentsDataSource defines the data source implementation.
+type UptimeIncidentsDataSource struct {
+	client *client.Client
+}
+
+// UptimeIncidentsDataSourceModel describes the data source data model.
+type UptimeIncidentsDataSourceModel struct {
+	Limit     types.Int64  `tfsdk:"limit"`
+	Status    types.String `tfsdk:"status"`
+	MonitorID types.Int64  `tfsdk:"monitor_id"`
+	Incidents types.List   `tfsdk:"incidents"`
+}
+
+func (d *UptimeIncidentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_uptime_incidents"
+}
+
+func (d *UptimeIncidentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Phare uptime incidents (status page incidents).",
+
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of incidents to return (1-%d), defaults to `%d`", maxIncidentsLimit, defaultIncidentsLimit),
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxIncidentsLimit),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return incidents with this status: `ongoing` or `resolved`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(incidentStatuses...),
+				},
+			},
+			"monitor_id": schema.Int64Attribute{
+				MarkdownDescription: "Only return the incidents of this uptime monitor",
+				Optional:            true,
+			},
+			"incidents": schema.ListNestedAttribute{
+				MarkdownDescription: "The incidents, in the order returned by the API",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the incident",
+							Computed:            true,
+						},
+						"project_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the project this incident belongs to",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the incident",
+							Computed:            true,
+						},
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "The URL-friendly slug for the incident",
+							Computed:            true,
+						},
+						"impact": schema.StringAttribute{
+							MarkdownDescription: "The impact level of the incident (e.g., majorOutage, degradedPerformance)",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "The current state of the incident (e.g., investigating, identified, monitoring)",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Current status of the incident (ongoing or resolved)",
+							Computed:            true,
+						},
+						"incident_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the incident occurred",
+							Computed:            true,
+						},
+						"recovery_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the incident was recovered (if resolved)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UptimeIncidentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UptimeIncidentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UptimeIncidentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Limit.IsNull() {
+		data.Limit = types.Int64Value(defaultIncidentsLimit)
+	}
+
+	limit := int(data.Limit.ValueInt64())
+	status := data.Status.ValueString()
+
+	tflog.Debug(ctx, "Listing uptime incidents", map[string]any{
+		"limit":      limit,
+		"status":     status,
+		"monitor_id": data.MonitorID.ValueInt64(),
+	})
+
+	var incidents []client.Incident
+	var err error
+	if !data.MonitorID.IsNull() {
+		incidents, err = d.client.ListMonitorIncidents(ctx, int(data.MonitorID.ValueInt64()))
+	} else if status != "" {
+		// The API doesn't filter by status, read every incident before filtering
+		incidents, err = d.client.ListIncidents(ctx, 0)
+	} else {
+		incidents, err = d.client.ListIncidents(ctx, limit)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list incidents", err.Error())
+		return
+	}
+	incidents = filterIncidents(incidents, status, limit)
+
+	incidentList, diags := incidentListValue(incidents)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Incidents = incidentList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterIncidents returns up to limit incidents, keeping only incidents with
+// the given status when it isn't empty
+func filterIncidents(incidents []client.Incident, status string, limit int) []client.Incident {
+	filtered := make([]client.Incident, 0, min(len(incidents), limit))
+	for _, incident := range incidents {
+		if len(filtered) == limit {
+			break
+		}
+		if status != "" && incident.Status != status {
+			continue
+		}
+		filtered = append(filtered, incident)
+	}
+
+	return filtered
+}
+
+// incidentAttrTypes returns the attribute types of an incidents list element
+func incidentAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"project_id":  types.Int64Type,
+		"title":       types.StringType,
+		"slug":        types.StringType,
+		"impact":      types.StringType,
+		"state":       types.StringType,
+		"status":      types.StringType,
+		"incident_at": types.StringType,
+		"recovery_at": types.StringType,
+	}
+}
+
+// incidentListValue converts API incidents to an incidents list value
+func incidentListValue(incidents []client.Incident) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elements := make([]attr.Value, 0, len(incidents))
+	for _, incident := range incidents {
+		id := types.StringNull()
+		if incident.ID != nil {
+			id = types.StringValue(fmt.Sprintf("%d", *incident.ID))
+		}
+
+		projectID := types.Int64Null()
+		if incident.ProjectID != nil {
+			projectID = types.Int64Value(int64(*incident.ProjectID))
+		}
+
+		incidentObj, objDiags := types.ObjectValue(incidentAttrTypes(), map[string]attr.Value{
+			"id":          id,
+			"project_id":  projectID,
+			"title":       types.StringValue(incident.Title),
+			"slug":        types.StringValue(incident.Slug),
+			"impact":      types.StringValue(incident.Impact),
+			"state":       types.StringValue(incident.State),
+			"status":      types.StringValue(incident.Status),
+			"incident_at": types.StringValue(incident.IncidentAt),
+			"recovery_at": types.StringPointerValue(incident.RecoveryAt),
+		})
+		diags.Append(objDiags...)
+		elements = append(elements, incidentObj)
+	}
+
+	list, listDiags := types.ListValue(types.ObjectType{AttrTypes: incidentAttrTypes()}, elements)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+func TestAccUptimeIncidentsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Default limit
+			{
+				Config: `
+data "phare_uptime_incidents" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.phare_uptime_incidents.test", "limit", "100"),
+					resource.TestCheckResourceAttrSet("data.phare_uptime_incidents.test", "incidents.#"),
+				),
+			},
+			// Explicit limit caps the number of incidents
+			{
+				Config: `
+data "phare_uptime_incidents" "test" {
+  limit = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.phare_uptime_incidents.test", "incidents.#", "1"),
+					resource.TestCheckResourceAttrSet("data.phare_uptime_incidents.test", "incidents.0.id"),
+				),
+			},
+			// Status filter
+			{
+				Config: `
+data "phare_uptime_incidents" "test" {
+  status = "resolved"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.phare_uptime_incidents.test", "incidents.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.phare_uptime_incidents.test", "incidents.*", map[string]string{
+						"status": "resolved",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterIncidents(t *testing.T) {
+	incidents := []client.Incident{
+		{Title: "First", Status: "resolved"},
+		{Title: "Second", Status: "ongoing"},
+		{Title: "Third", Status: "resolved"},
+		{Title: "Fourth", Status: "resolved"},
+	}
+
+	tests := []struct {
+		name   string
+		status string
+		limit  int
+		want   []string
+	}{
+		{
+			name:  "unfiltered",
+			limit: 3,
+			want:  []string{"First", "Second", "Third"},
+		},
+		{
+			name:   "filtered by status",
+			status: "resolved",
+			limit:  100,
+			want:   []string{"First", "Third", "Fourth"},
+		},
+		{
+			name:   "limit applies after filtering",
+			status: "resolved",
+			limit:  2,
+			want:   []string{"First", "Third"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, incident := range filterIncidents(incidents, tt.status, tt.limit) {
+				got = append(got, incident.Title)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("filterIncidents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIncidentListValue(t *testing.T) {
+	id := 221114
+	recoveryAt := "2024-01-01T01:00:00Z"
+
+	list, diags := incidentListValue([]client.Incident{
+		{ID: &id, Title: "Outage", Status: "resolved", IncidentAt: "2024-01-01T00:00:00Z", RecoveryAt: &recoveryAt},
+		{Title: "Ongoing", Status: "ongoing", IncidentAt: "2024-01-02T00:00:00Z"},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	elements := list.Elements()
+	if len(elements) != 2 {
+		t.Fatalf("got %d incidents, want 2", len(elements))
+	}
+
+	first := elements[0].(basetypes.ObjectValue).Attributes()
+	if !first["id"].Equal(types.StringValue("221114")) {
+		t.Errorf("id = %v, want \"221114\"", first["id"])
+	}
+	if !first["recovery_at"].Equal(types.StringValue(recoveryAt)) {
+		t.Errorf("recovery_at = %v, want %q", first["recovery_at"], recoveryAt)
+	}
+
+	second := elements[1].(basetypes.ObjectValue).Attributes()
+	if !second["id"].IsNull() || !second["project_id"].IsNull() || !second["recovery_at"].IsNull() {
+		t.Errorf("missing fields should be null, got %v", second)
+	}
+}
@@ -0,0 +1,413 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UptimeMonitorDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &UptimeMonitorDataSource{}
+
+func NewUptimeMonitorDataSource() datasource.DataSource {
+	return &UptimeMonitorDataSource{}
+}
+
+// UptimeMonitorDataSource defines the data source implementation. It shares
+// the data model and API conversion of UptimeMonitorResource.
+type UptimeMonitorDataSource struct {
+	client *client.Client
+}
+
+func (d *UptimeMonitorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_uptime_monitor"
+}
+
+func (d *UptimeMonitorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := uptimeMonitorComputedAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The unique identifier of the monitor. Exactly one of `id` or `name` must be set",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the monitor. Exactly one of `id` or `name` must be set, the name must match a single monitor",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["tags"] = schema.MapAttribute{
+		MarkdownDescription: "Always null, tags are only stored in the Terraform state of `phare_uptime_monitor`",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["base_url"] = schema.StringAttribute{
+		MarkdownDescription: "Phare API base URL to read the monitor from, overriding the provider `base_url`",
+		Optional:            true,
+		Validators: []validator.String{
+			baseURLValidator{},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a Phare uptime monitor by ID or name.",
+		Attributes:          attributes,
+	}
+}
+
+// uptimeMonitorComputedAttributes returns the read-only attributes describing
+// a monitor, shared by the uptime monitor data sources
+func uptimeMonitorComputedAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"protocol": schema.StringAttribute{
+			MarkdownDescription: "Monitoring protocol: `http`, `tcp`, `icmp`, `dns` or `heartbeat`",
+			Computed:            true,
+		},
+		"http_request": schema.SingleNestedAttribute{
+			MarkdownDescription: "HTTP request configuration, set when protocol is `http`",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"method": schema.StringAttribute{
+					MarkdownDescription: "HTTP method",
+					Computed:            true,
+				},
+				"url": schema.StringAttribute{
+					MarkdownDescription: "URL monitored, including its query parameters",
+					Computed:            true,
+				},
+				"tls_skip_verify": schema.BoolAttribute{
+					MarkdownDescription: "Whether SSL certificate verification is skipped",
+					Computed:            true,
+				},
+				"tls": schema.SingleNestedAttribute{
+					MarkdownDescription: "TLS settings",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"min_version": schema.StringAttribute{
+							MarkdownDescription: "Minimum accepted TLS version",
+							Computed:            true,
+						},
+						"server_name": schema.StringAttribute{
+							MarkdownDescription: "Server name (SNI) sent during the TLS handshake",
+							Computed:            true,
+						},
+					},
+				},
+				"body": schema.StringAttribute{
+					MarkdownDescription: "Request body",
+					Computed:            true,
+				},
+				"body_file": schema.StringAttribute{
+					MarkdownDescription: "Always null, the file contents are returned in `body`",
+					Computed:            true,
+				},
+				"follow_redirects": schema.BoolAttribute{
+					MarkdownDescription: "Whether HTTP redirects are followed",
+					Computed:            true,
+				},
+				"http_version": schema.StringAttribute{
+					MarkdownDescription: "HTTP protocol version used by checks: `1.1`, `2`, or `auto`",
+					Computed:            true,
+				},
+				"user_agent_secret": schema.StringAttribute{
+					MarkdownDescription: "Secret value for User-Agent header authentication",
+					Computed:            true,
+					Sensitive:           true,
+				},
+				"query_params": schema.MapAttribute{
+					MarkdownDescription: "Always null, query parameters are included in `url`",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"cookies": schema.MapAttribute{
+					MarkdownDescription: "Cookies sent with the request, keyed by cookie name",
+					Computed:            true,
+					Sensitive:           true,
+					ElementType:         types.StringType,
+				},
+				"headers": schema.SetNestedAttribute{
+					MarkdownDescription: "Additional HTTP headers",
+					Computed:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								MarkdownDescription: "Header name",
+								Computed:            true,
+							},
+							"value": schema.StringAttribute{
+								MarkdownDescription: "Header value",
+								Computed:            true,
+							},
+						},
+					},
+				},
+			},
+		},
+		"tcp_request": schema.SingleNestedAttribute{
+			MarkdownDescription: "TCP request configuration, set when protocol is `tcp`",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"host": schema.StringAttribute{
+					MarkdownDescription: "TCP hostname or IP address",
+					Computed:            true,
+				},
+				"port": schema.StringAttribute{
+					MarkdownDescription: "TCP port",
+					Computed:            true,
+				},
+				"connection": schema.StringAttribute{
+					MarkdownDescription: "Connection type: `plain` or `tls`",
+					Computed:            true,
+				},
+				"tls_skip_verify": schema.BoolAttribute{
+					MarkdownDescription: "Whether TLS certificate verification is skipped",
+					Computed:            true,
+				},
+				"tls": schema.SingleNestedAttribute{
+					MarkdownDescription: "TLS settings",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"min_version": schema.StringAttribute{
+							MarkdownDescription: "Minimum accepted TLS version",
+							Computed:            true,
+						},
+						"server_name": schema.StringAttribute{
+							MarkdownDescription: "Server name (SNI) sent during the TLS handshake",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		"icmp_request": schema.SingleNestedAttribute{
+			MarkdownDescription: "ICMP (ping) request configuration, set when protocol is `icmp`",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"host": schema.StringAttribute{
+					MarkdownDescription: "Hostname or IP address pinged",
+					Computed:            true,
+				},
+				"packet_count": schema.Int64Attribute{
+					MarkdownDescription: "Number of packets sent by each check",
+					Computed:            true,
+				},
+				"packet_size": schema.Int64Attribute{
+					MarkdownDescription: "Payload size of each packet in bytes",
+					Computed:            true,
+				},
+			},
+		},
+		"dns_request": schema.SingleNestedAttribute{
+			MarkdownDescription: "DNS request configuration, set when protocol is `dns`",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"host": schema.StringAttribute{
+					MarkdownDescription: "Domain name resolved",
+					Computed:            true,
+				},
+				"record_type": schema.StringAttribute{
+					MarkdownDescription: "DNS record type resolved",
+					Computed:            true,
+				},
+				"resolver": schema.StringAttribute{
+					MarkdownDescription: "IP address of the DNS resolver queried",
+					Computed:            true,
+				},
+			},
+		},
+		"heartbeat_request": schema.SingleNestedAttribute{
+			MarkdownDescription: "Heartbeat configuration, set when protocol is `heartbeat`",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"period": schema.Int64Attribute{
+					MarkdownDescription: "Expected number of seconds between two pings",
+					Computed:            true,
+				},
+				"grace": schema.Int64Attribute{
+					MarkdownDescription: "Number of seconds a ping can be late before the monitor fails",
+					Computed:            true,
+				},
+			},
+		},
+		"interval": schema.Int64Attribute{
+			MarkdownDescription: "Monitoring interval in seconds",
+			Computed:            true,
+		},
+		"timeout": schema.Int64Attribute{
+			MarkdownDescription: "Monitoring timeout in milliseconds",
+			Computed:            true,
+		},
+		"incident_confirmations": schema.Int64Attribute{
+			MarkdownDescription: "Number of failed checks required to create an incident",
+			Computed:            true,
+		},
+		"recovery_confirmations": schema.Int64Attribute{
+			MarkdownDescription: "Number of successful checks required to resolve an incident",
+			Computed:            true,
+		},
+		"regions": schema.SetAttribute{
+			MarkdownDescription: "Set of regions where monitoring checks are performed",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"success_assertions": schema.SetNestedAttribute{
+			MarkdownDescription: "Set of assertions that must be true for check success",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Assertion type",
+						Computed:            true,
+					},
+					"operator": schema.StringAttribute{
+						MarkdownDescription: "Comparison operator",
+						Computed:            true,
+					},
+					"value": schema.StringAttribute{
+						MarkdownDescription: "Expected value",
+						Computed:            true,
+					},
+					"property": schema.StringAttribute{
+						MarkdownDescription: "Header name for `response_header` assertions",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"paused": schema.BoolAttribute{
+			MarkdownDescription: "Whether the monitor is paused",
+			Computed:            true,
+		},
+		"estimated_checks_per_month": schema.Int64Attribute{
+			MarkdownDescription: "Estimated number of checks performed per month, computed as `30 * 24 * 3600 / interval * length(regions)`",
+			Computed:            true,
+		},
+		"ping_url": schema.StringAttribute{
+			MarkdownDescription: "URL cron jobs and workers request to check in, null unless protocol is `heartbeat`",
+			Computed:            true,
+			Sensitive:           true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Current status of the monitor: `up`, `down`, `paused` or `pending`",
+			Computed:            true,
+		},
+		"resolved_ip_family": schema.StringAttribute{
+			MarkdownDescription: "IP address family (`ipv4` or `ipv6`) used by the most recent check",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the monitor was created",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the monitor was last updated",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *UptimeMonitorDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *UptimeMonitorDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UptimeMonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UptimeMonitorResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api := scopedClient(d.client, data.BaseURL)
+
+	var monitor *client.Monitor
+	if !data.ID.IsNull() {
+		tflog.Debug(ctx, "Reading uptime monitor", map[string]any{"id": data.ID.ValueString()})
+
+		id, err := strconv.Atoi(data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid monitor ID", fmt.Sprintf("Failed to parse monitor ID: %s", err.Error()))
+			return
+		}
+
+		monitor, err = api.GetMonitor(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read monitor", err.Error())
+			return
+		}
+	} else {
+		tflog.Debug(ctx, "Looking up uptime monitor by name", map[string]any{"name": data.Name.ValueString()})
+
+		monitors, err := api.ListMonitors(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list monitors", err.Error())
+			return
+		}
+
+		monitor, err = findMonitorByName(monitors, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Failed to find monitor", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append((&UptimeMonitorResource{}).apiToTerraformModel(ctx, monitor, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findMonitorByName returns the single monitor with the given name
+func findMonitorByName(monitors []client.Monitor, name string) (*client.Monitor, error) {
+	var matches []client.Monitor
+	for _, monitor := range monitors {
+		if monitor.Name == name {
+			matches = append(matches, monitor)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no monitor named %q was found", name)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d monitors are named %q, use id to select one of them", len(matches), name)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+func TestAccUptimeMonitorDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUptimeMonitorDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.phare_uptime_monitor.by_id", "name",
+						"phare_uptime_monitor.test", "name",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.phare_uptime_monitor.by_name", "id",
+						"phare_uptime_monitor.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.phare_uptime_monitor.by_name", "http_request.url", "https://immich.app"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUptimeMonitorDataSourceConfig() string {
+	return `
+resource "phare_uptime_monitor" "test" {
+  name     = "Test Lookup Monitor"
+  protocol = "http"
+
+  http_request = {
+    method = "GET"
+    url    = "https://immich.app"
+  }
+
+  interval                = 60
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+}
+
+data "phare_uptime_monitor" "by_id" {
+  id = phare_uptime_monitor.test.id
+}
+
+data "phare_uptime_monitor" "by_name" {
+  name = phare_uptime_monitor.test.name
+}
+`
+}
+
+func TestUptimeMonitorDataSourceStateMatchesSchema(t *testing.T) {
+	ctx := context.Background()
+	d := &UptimeMonitorDataSource{}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	id := 1
+	monitor := &client.Monitor{
+		ID:       &id,
+		Name:     "api",
+		Protocol: "http",
+		Interval: 60,
+		Regions:  []string{"na-usa-iad"},
+		Request: client.MonitorRequest{
+			Method:  stringPtr("GET"),
+			URL:     stringPtr("https://example.com"),
+			Headers: []client.RequestHeader{{Name: "Cookie", Value: "session=abc"}},
+		},
+	}
+
+	data := UptimeMonitorResourceModel{Tags: types.MapNull(types.StringType)}
+	diags := (&UptimeMonitorResource{}).apiToTerraformModel(ctx, monitor, &data)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags.Append(state.Set(ctx, &data)...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestFindMonitorByName(t *testing.T) {
+	id1, id2, id3 := 1, 2, 3
+	monitors := []client.Monitor{
+		{ID: &id1, Name: "api"},
+		{ID: &id2, Name: "web"},
+		{ID: &id3, Name: "web"},
+	}
+
+	tests := []struct {
+		name      string
+		lookup    string
+		wantID    int
+		wantError bool
+	}{
+		{name: "unique name", lookup: "api", wantID: 1},
+		{name: "ambiguous name", lookup: "web", wantError: true},
+		{name: "unknown name", lookup: "db", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			monitor, err := findMonitorByName(monitors, tt.lookup)
+			if tt.wantError {
+				if err == nil {
+					t.Fatalf("expected error, got monitor %d", *monitor.ID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *monitor.ID != tt.wantID {
+				t.Errorf("monitor id = %d, want %d", *monitor.ID, tt.wantID)
+			}
+		})
+	}
+}
@@ -6,14 +6,31 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/phare/terraform-provider-phare/internal/client"
+	"github.com/phare/terraform-provider-phare/internal/planmodifiers"
 )
 
+// secondsPerMonth is the number of seconds in a 30 day month
+const secondsPerMonth = 30 * 24 * 3600
+
+// defaultAssertionOperators are the operators sent for success assertions
+// configured without one, keyed by assertion type
+var defaultAssertionOperators = map[string]string{
+	"status_code":             "in",
+	"response_header":         "equals",
+	"response_body":           "contains",
+	"response_time":           "less_than",
+	"certificate_expiry_days": "less_than",
+	"record_value":            "contains",
+}
+
 // terraformToAPIModel converts Terraform model to API client model
 func (r *UptimeMonitorResource) terraformToAPIModel(ctx context.Context, data *UptimeMonitorResourceModel) (*client.Monitor, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -27,34 +44,59 @@ func (r *UptimeMonitorResource) terraformToAPIModel(ctx context.Context, data *U
 		RecoveryConfirmations: int(data.RecoveryConfirmations.ValueInt64()),
 	}
 
-	// Convert regions
+	// Convert regions, sending the canonical lowercase region codes
 	var regions []string
 	diags.Append(data.Regions.ElementsAs(ctx, &regions, false)...)
+	for i, region := range regions {
+		regions[i] = strings.ToLower(region)
+	}
 	monitor.Regions = regions
 
 	// Convert protocol-specific request
+	// protocolRequestValidator ensures the request of the protocol is set
 	if data.Protocol.ValueString() == "http" {
-		if data.HTTPRequest.IsNull() {
-			diags.AddError("Invalid Configuration", "http_request is required when protocol is 'http'")
-			return nil, diags
-		}
-
 		var httpReq HTTPRequestModel
 		diags.Append(data.HTTPRequest.As(ctx, &httpReq, basetypes.ObjectAsOptions{})...)
 
+		requestURL := httpReq.URL.ValueString()
+		if !httpReq.QueryParams.IsNull() {
+			var params map[string]string
+			diags.Append(httpReq.QueryParams.ElementsAs(ctx, &params, false)...)
+
+			var err error
+			requestURL, err = appendQueryParams(requestURL, params)
+			if err != nil {
+				diags.AddError("Invalid Configuration", fmt.Sprintf("Unable to append query_params to url: %s", err))
+				return nil, diags
+			}
+		}
+
 		monitor.Request = client.MonitorRequest{
 			Method:          stringPtr(httpReq.Method.ValueString()),
-			URL:             stringPtr(httpReq.URL.ValueString()),
+			URL:             stringPtr(requestURL),
 			TLSSkipVerify:   boolPtr(httpReq.TLSSkipVerify.ValueBool()),
 			FollowRedirects: boolPtr(httpReq.FollowRedirects.ValueBool()),
 		}
 
-		if !httpReq.Body.IsNull() {
+		// The file is read again on apply, as body is unknown when body_file
+		// wasn't known during the plan
+		if !httpReq.BodyFile.IsNull() {
+			body, err := readBodyFile(httpReq.BodyFile.ValueString())
+			if err != nil {
+				diags.AddError("Invalid Configuration", fmt.Sprintf("Unable to read body_file: %s", err))
+				return nil, diags
+			}
+			monitor.Request.Body = stringPtr(body)
+		} else if !httpReq.Body.IsNull() && !httpReq.Body.IsUnknown() {
 			monitor.Request.Body = stringPtr(httpReq.Body.ValueString())
 		}
 		if !httpReq.UserAgentSecret.IsNull() {
 			monitor.Request.UserAgentSecret = stringPtr(httpReq.UserAgentSecret.ValueString())
 		}
+		diags.Append(setTLSSettings(ctx, httpReq.TLS, &monitor.Request)...)
+		if version := httpReq.HTTPVersion.ValueString(); version != "" && version != httpVersionAuto {
+			monitor.Request.HTTPVersion = stringPtr(version)
+		}
 
 		// Convert headers
 		if !httpReq.Headers.IsNull() {
@@ -69,12 +111,20 @@ func (r *UptimeMonitorResource) terraformToAPIModel(ctx context.Context, data *U
 				}
 			}
 		}
-	} else if data.Protocol.ValueString() == "tcp" {
-		if data.TCPRequest.IsNull() {
-			diags.AddError("Invalid Configuration", "tcp_request is required when protocol is 'tcp'")
-			return nil, diags
-		}
 
+		// Convert cookies
+		if !httpReq.Cookies.IsNull() {
+			var cookies map[string]string
+			diags.Append(httpReq.Cookies.ElementsAs(ctx, &cookies, false)...)
+
+			if len(cookies) > 0 {
+				monitor.Request.Headers = append(monitor.Request.Headers, client.RequestHeader{
+					Name:  cookieHeaderName,
+					Value: buildCookieHeader(cookies),
+				})
+			}
+		}
+	} else if data.Protocol.ValueString() == "tcp" {
 		var tcpReq TCPRequestModel
 		diags.Append(data.TCPRequest.As(ctx, &tcpReq, basetypes.ObjectAsOptions{})...)
 
@@ -84,10 +134,48 @@ func (r *UptimeMonitorResource) terraformToAPIModel(ctx context.Context, data *U
 			Connection:    stringPtr(tcpReq.Connection.ValueString()),
 			TLSSkipVerify: boolPtr(tcpReq.TLSSkipVerify.ValueBool()),
 		}
+		diags.Append(setTLSSettings(ctx, tcpReq.TLS, &monitor.Request)...)
+	} else if data.Protocol.ValueString() == "icmp" {
+		var icmpReq ICMPRequestModel
+		diags.Append(data.ICMPRequest.As(ctx, &icmpReq, basetypes.ObjectAsOptions{})...)
+
+		packetCount := int(icmpReq.PacketCount.ValueInt64())
+		monitor.Request = client.MonitorRequest{
+			Host:        stringPtr(icmpReq.Host.ValueString()),
+			PacketCount: &packetCount,
+		}
+
+		if !icmpReq.PacketSize.IsNull() {
+			packetSize := int(icmpReq.PacketSize.ValueInt64())
+			monitor.Request.PacketSize = &packetSize
+		}
+	} else if data.Protocol.ValueString() == "dns" {
+		var dnsReq DNSRequestModel
+		diags.Append(data.DNSRequest.As(ctx, &dnsReq, basetypes.ObjectAsOptions{})...)
+
+		monitor.Request = client.MonitorRequest{
+			Host:       stringPtr(dnsReq.Host.ValueString()),
+			RecordType: stringPtr(dnsReq.RecordType.ValueString()),
+		}
+
+		if !dnsReq.Resolver.IsNull() {
+			monitor.Request.Resolver = stringPtr(dnsReq.Resolver.ValueString())
+		}
+	} else if data.Protocol.ValueString() == "heartbeat" {
+		var heartbeatReq HeartbeatRequestModel
+		diags.Append(data.HeartbeatRequest.As(ctx, &heartbeatReq, basetypes.ObjectAsOptions{})...)
+
+		period := int(heartbeatReq.Period.ValueInt64())
+		grace := int(heartbeatReq.Grace.ValueInt64())
+		monitor.Request = client.MonitorRequest{
+			Period: &period,
+			Grace:  &grace,
+		}
 	}
 
-	// Convert success assertions
-	if !data.SuccessAssertions.IsNull() {
+	// Convert success assertions. Unknown assertions are left unset so that
+	// the API applies its default
+	if !data.SuccessAssertions.IsNull() && !data.SuccessAssertions.IsUnknown() {
 		var assertions []SuccessAssertionModel
 		diags.Append(data.SuccessAssertions.ElementsAs(ctx, &assertions, false)...)
 
@@ -98,6 +186,8 @@ func (r *UptimeMonitorResource) terraformToAPIModel(ctx context.Context, data *U
 			}
 			if !a.Operator.IsNull() {
 				assertion.Operator = stringPtr(a.Operator.ValueString())
+			} else if operator, ok := defaultAssertionOperators[a.Type.ValueString()]; ok {
+				assertion.Operator = stringPtr(operator)
 			}
 			if !a.Value.IsNull() {
 				assertion.Value = stringPtr(a.Value.ValueString())
@@ -126,47 +216,122 @@ func (r *UptimeMonitorResource) apiToTerraformModel(ctx context.Context, monitor
 	data.IncidentConfirmations = types.Int64Value(int64(monitor.IncidentConfirmations))
 	data.RecoveryConfirmations = types.Int64Value(int64(monitor.RecoveryConfirmations))
 
-	if monitor.CreatedAt != nil {
-		data.CreatedAt = types.StringValue(*monitor.CreatedAt)
-	}
-	if monitor.UpdatedAt != nil {
-		data.UpdatedAt = types.StringValue(*monitor.UpdatedAt)
-	}
+	data.CreatedAt = timestampValue(monitor.CreatedAt, data.CreatedAt)
+	data.UpdatedAt = timestampValue(monitor.UpdatedAt, data.UpdatedAt)
 	if monitor.Paused != nil {
 		data.Paused = types.BoolValue(*monitor.Paused)
 	} else {
 		data.Paused = types.BoolValue(false)
 	}
+	data.Status = types.StringPointerValue(monitor.Status)
+	data.ResolvedIPFamily = types.StringPointerValue(monitor.ResolvedIPFamily)
 
-	// Convert regions
-	regionElements := make([]attr.Value, len(monitor.Regions))
-	for i, r := range monitor.Regions {
-		regionElements[i] = types.StringValue(r)
+	// Only heartbeat monitors have a ping URL, keep the known one when a
+	// response omits it
+	switch {
+	case monitor.PingURL != nil:
+		data.PingURL = types.StringValue(*monitor.PingURL)
+	case monitor.Protocol != "heartbeat" || data.PingURL.IsUnknown():
+		data.PingURL = types.StringNull()
 	}
-	regionList, diagList := types.ListValue(types.StringType, regionElements)
-	diags.Append(diagList...)
-	data.Regions = regionList
 
-	// Convert protocol-specific request
-	if monitor.Protocol == "http" {
+	// Convert regions to lowercase, keeping the configured casing of regions
+	// that only differ by case so that they don't produce a diff
+	var priorRegions []string
+	if !data.Regions.IsNull() && !data.Regions.IsUnknown() {
+		diags.Append(data.Regions.ElementsAs(ctx, &priorRegions, false)...)
+	}
+
+	// The API may return no regions while a monitor is being provisioned, keep
+	// the prior regions rather than storing a set rejected on the next plan
+	if len(monitor.Regions) == 0 {
+		detail := "The Phare API returned no regions for this monitor, which can happen while it is being provisioned."
+		if len(priorRegions) > 0 {
+			detail += " The regions from the previous state are kept."
+		}
+		diags.AddWarning("Monitor Has No Regions", detail)
+	}
+
+	if len(monitor.Regions) > 0 || len(priorRegions) == 0 {
+		regionElements := make([]attr.Value, len(monitor.Regions))
+		for i, r := range monitor.Regions {
+			region := strings.ToLower(r)
+			if j := slices.IndexFunc(priorRegions, func(prior string) bool { return strings.EqualFold(prior, r) }); j >= 0 {
+				region = priorRegions[j]
+			}
+			regionElements[i] = types.StringValue(region)
+		}
+		regionSet, diagSet := types.SetValue(types.StringType, regionElements)
+		diags.Append(diagSet...)
+		data.Regions = regionSet
+	}
+
+	// Estimate the monthly check volume, assuming a 30 day month. The regions
+	// in state are counted as they may be the prior ones kept above
+	if monitor.Interval > 0 {
+		data.EstimatedChecks = types.Int64Value(int64(secondsPerMonth / monitor.Interval * len(data.Regions.Elements())))
+	} else {
+		data.EstimatedChecks = types.Int64Null()
+	}
+
+	// Convert protocol-specific request. The API may echo fields of the other
+	// protocol, so only the fields belonging to the monitor protocol are read
+	// and the request object of the other protocol is always null. Fields with
+	// schema defaults fall back to those defaults when the API omits them.
+	switch monitor.Protocol {
+	case "http":
 		httpReq := HTTPRequestModel{
 			Method:          types.StringPointerValue(monitor.Request.Method),
 			URL:             types.StringPointerValue(monitor.Request.URL),
-			TLSSkipVerify:   types.BoolPointerValue(monitor.Request.TLSSkipVerify),
-			FollowRedirects: types.BoolPointerValue(monitor.Request.FollowRedirects),
+			QueryParams:     types.MapNull(types.StringType),
+			TLSSkipVerify:   types.BoolValue(boolValueOrDefault(monitor.Request.TLSSkipVerify, false)),
+			TLS:             tlsValue(monitor.Request, &diags),
+			FollowRedirects: types.BoolValue(boolValueOrDefault(monitor.Request.FollowRedirects, true)),
+			HTTPVersion:     types.StringValue(httpVersionAuto),
 			Body:            types.StringPointerValue(monitor.Request.Body),
+			BodyFile:        priorBodyFile(ctx, data, &diags),
 			UserAgentSecret: types.StringPointerValue(monitor.Request.UserAgentSecret),
 		}
 
+		if monitor.Request.HTTPVersion != nil {
+			httpReq.HTTPVersion = types.StringValue(*monitor.Request.HTTPVersion)
+		}
+
+		// Move the parameters previously managed through query_params back out
+		// of the URL so that they don't show up as a diff on the literal url
+		if monitor.Request.URL != nil {
+			if keys := priorQueryParamKeys(ctx, data, &diags); keys != nil {
+				requestURL, params := splitQueryParams(*monitor.Request.URL, keys)
+				paramsMap, diagMap := types.MapValueFrom(ctx, types.StringType, params)
+				diags.Append(diagMap...)
+				httpReq.URL = types.StringValue(requestURL)
+				httpReq.QueryParams = paramsMap
+			}
+		}
+
+		// Move the Cookie header into cookies unless it was configured as a
+		// plain header, so that cookies don't show up as a diff on headers
+		headers := monitor.Request.Headers
+		httpReq.Cookies = types.MapNull(types.StringType)
+		if !priorHasCookieHeader(ctx, data, &diags) {
+			headers = make([]client.RequestHeader, 0, len(monitor.Request.Headers))
+			for _, h := range monitor.Request.Headers {
+				if !strings.EqualFold(h.Name, cookieHeaderName) {
+					headers = append(headers, h)
+					continue
+				}
+				cookies, diagMap := types.MapValueFrom(ctx, types.StringType, parseCookieHeader(h.Value))
+				diags.Append(diagMap...)
+				httpReq.Cookies = cookies
+			}
+		}
+
 		// Convert headers
-		if len(monitor.Request.Headers) > 0 {
-			headerElements := make([]attr.Value, len(monitor.Request.Headers))
-			for i, h := range monitor.Request.Headers {
+		if len(headers) > 0 {
+			headerElements := make([]attr.Value, len(headers))
+			for i, h := range headers {
 				headerObj, diagObj := types.ObjectValue(
-					map[string]attr.Type{
-						"name":  types.StringType,
-						"value": types.StringType,
-					},
+					requestHeaderAttrTypes(),
 					map[string]attr.Value{
 						"name":  types.StringValue(h.Name),
 						"value": types.StringValue(h.Value),
@@ -175,116 +340,329 @@ func (r *UptimeMonitorResource) apiToTerraformModel(ctx context.Context, monitor
 				diags.Append(diagObj...)
 				headerElements[i] = headerObj
 			}
-			headerList, diagList := types.ListValue(
-				types.ObjectType{AttrTypes: map[string]attr.Type{
-					"name":  types.StringType,
-					"value": types.StringType,
-				}},
+			headerSet, diagSet := types.SetValue(
+				types.ObjectType{AttrTypes: requestHeaderAttrTypes()},
 				headerElements,
 			)
-			diags.Append(diagList...)
-			httpReq.Headers = headerList
+			diags.Append(diagSet...)
+			httpReq.Headers = headerSet
 		} else {
-			httpReq.Headers = types.ListNull(types.ObjectType{AttrTypes: map[string]attr.Type{
-				"name":  types.StringType,
-				"value": types.StringType,
-			}})
+			httpReq.Headers = types.SetNull(types.ObjectType{AttrTypes: requestHeaderAttrTypes()})
 		}
 
-		httpObj, diagObj := types.ObjectValueFrom(ctx, map[string]attr.Type{
-			"method":            types.StringType,
-			"url":               types.StringType,
-			"tls_skip_verify":   types.BoolType,
-			"body":              types.StringType,
-			"follow_redirects":  types.BoolType,
-			"user_agent_secret": types.StringType,
-			"headers": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
-				"name":  types.StringType,
-				"value": types.StringType,
-			}}},
-		}, httpReq)
+		httpObj, diagObj := types.ObjectValueFrom(ctx, httpRequestAttrTypes(), httpReq)
 		diags.Append(diagObj...)
 		data.HTTPRequest = httpObj
-		data.TCPRequest = types.ObjectNull(map[string]attr.Type{
-			"host":            types.StringType,
-			"port":            types.StringType,
-			"connection":      types.StringType,
-			"tls_skip_verify": types.BoolType,
-		})
-	} else if monitor.Protocol == "tcp" {
+		data.TCPRequest = types.ObjectNull(tcpRequestAttrTypes())
+		data.ICMPRequest = types.ObjectNull(icmpRequestAttrTypes())
+		data.DNSRequest = types.ObjectNull(dnsRequestAttrTypes())
+		data.HeartbeatRequest = types.ObjectNull(heartbeatRequestAttrTypes())
+	case "tcp":
 		tcpReq := TCPRequestModel{
 			Host:          types.StringPointerValue(monitor.Request.Host),
 			Port:          types.StringPointerValue(monitor.Request.Port),
 			Connection:    types.StringPointerValue(monitor.Request.Connection),
-			TLSSkipVerify: types.BoolPointerValue(monitor.Request.TLSSkipVerify),
+			TLSSkipVerify: types.BoolValue(boolValueOrDefault(monitor.Request.TLSSkipVerify, false)),
+			TLS:           tlsValue(monitor.Request, &diags),
 		}
 
-		tcpObj, diagObj := types.ObjectValueFrom(ctx, map[string]attr.Type{
-			"host":            types.StringType,
-			"port":            types.StringType,
-			"connection":      types.StringType,
-			"tls_skip_verify": types.BoolType,
-		}, tcpReq)
+		tcpObj, diagObj := types.ObjectValueFrom(ctx, tcpRequestAttrTypes(), tcpReq)
 		diags.Append(diagObj...)
 		data.TCPRequest = tcpObj
-		data.HTTPRequest = types.ObjectNull(map[string]attr.Type{
-			"method":            types.StringType,
-			"url":               types.StringType,
-			"tls_skip_verify":   types.BoolType,
-			"body":              types.StringType,
-			"follow_redirects":  types.BoolType,
-			"user_agent_secret": types.StringType,
-			"headers": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
-				"name":  types.StringType,
-				"value": types.StringType,
-			}}},
-		})
-	}
-
-	// Convert success assertions
+		data.HTTPRequest = types.ObjectNull(httpRequestAttrTypes())
+		data.ICMPRequest = types.ObjectNull(icmpRequestAttrTypes())
+		data.DNSRequest = types.ObjectNull(dnsRequestAttrTypes())
+		data.HeartbeatRequest = types.ObjectNull(heartbeatRequestAttrTypes())
+	case "icmp":
+		icmpReq := ICMPRequestModel{
+			Host:        types.StringPointerValue(monitor.Request.Host),
+			PacketCount: types.Int64Value(3),
+			PacketSize:  int64PointerValue(monitor.Request.PacketSize),
+		}
+		if monitor.Request.PacketCount != nil {
+			icmpReq.PacketCount = types.Int64Value(int64(*monitor.Request.PacketCount))
+		}
+
+		icmpObj, diagObj := types.ObjectValueFrom(ctx, icmpRequestAttrTypes(), icmpReq)
+		diags.Append(diagObj...)
+		data.ICMPRequest = icmpObj
+		data.HTTPRequest = types.ObjectNull(httpRequestAttrTypes())
+		data.TCPRequest = types.ObjectNull(tcpRequestAttrTypes())
+		data.DNSRequest = types.ObjectNull(dnsRequestAttrTypes())
+		data.HeartbeatRequest = types.ObjectNull(heartbeatRequestAttrTypes())
+	case "dns":
+		dnsReq := DNSRequestModel{
+			Host:       types.StringPointerValue(monitor.Request.Host),
+			RecordType: types.StringPointerValue(monitor.Request.RecordType),
+			Resolver:   types.StringPointerValue(monitor.Request.Resolver),
+		}
+
+		dnsObj, diagObj := types.ObjectValueFrom(ctx, dnsRequestAttrTypes(), dnsReq)
+		diags.Append(diagObj...)
+		data.DNSRequest = dnsObj
+		data.HTTPRequest = types.ObjectNull(httpRequestAttrTypes())
+		data.TCPRequest = types.ObjectNull(tcpRequestAttrTypes())
+		data.ICMPRequest = types.ObjectNull(icmpRequestAttrTypes())
+		data.HeartbeatRequest = types.ObjectNull(heartbeatRequestAttrTypes())
+	case "heartbeat":
+		heartbeatReq := HeartbeatRequestModel{
+			Period: int64PointerValue(monitor.Request.Period),
+			Grace:  int64PointerValue(monitor.Request.Grace),
+		}
+
+		heartbeatObj, diagObj := types.ObjectValueFrom(ctx, heartbeatRequestAttrTypes(), heartbeatReq)
+		diags.Append(diagObj...)
+		data.HeartbeatRequest = heartbeatObj
+		data.HTTPRequest = types.ObjectNull(httpRequestAttrTypes())
+		data.TCPRequest = types.ObjectNull(tcpRequestAttrTypes())
+		data.ICMPRequest = types.ObjectNull(icmpRequestAttrTypes())
+		data.DNSRequest = types.ObjectNull(dnsRequestAttrTypes())
+	default:
+		data.HTTPRequest = types.ObjectNull(httpRequestAttrTypes())
+		data.TCPRequest = types.ObjectNull(tcpRequestAttrTypes())
+		data.ICMPRequest = types.ObjectNull(icmpRequestAttrTypes())
+		data.DNSRequest = types.ObjectNull(dnsRequestAttrTypes())
+		data.HeartbeatRequest = types.ObjectNull(heartbeatRequestAttrTypes())
+	}
+
+	// Convert success assertions, keeping omitted operators null when the API
+	// returns the default operator sent for them
+	var priorAssertions []SuccessAssertionModel
+	if !data.SuccessAssertions.IsNull() && !data.SuccessAssertions.IsUnknown() {
+		diags.Append(data.SuccessAssertions.ElementsAs(ctx, &priorAssertions, false)...)
+	}
+
 	if len(monitor.SuccessAssertions) > 0 {
 		assertionElements := make([]attr.Value, len(monitor.SuccessAssertions))
 		for i, a := range monitor.SuccessAssertions {
+			operator := types.StringPointerValue(a.Operator)
+			if slices.ContainsFunc(priorAssertions, func(p SuccessAssertionModel) bool {
+				return p.Operator.IsNull() && assertionMatches(a, p)
+			}) {
+				operator = types.StringNull()
+			}
+
 			assertionObj, diagObj := types.ObjectValue(
-				map[string]attr.Type{
-					"type":     types.StringType,
-					"operator": types.StringType,
-					"value":    types.StringType,
-					"property": types.StringType,
-				},
+				successAssertionAttrTypes(),
 				map[string]attr.Value{
 					"type":     types.StringValue(a.Type),
-					"operator": types.StringPointerValue(a.Operator),
+					"operator": operator,
 					"value":    types.StringPointerValue(a.Value),
-					"property": types.StringPointerValue(a.Property),
+					"property": assertionPropertyValue(a),
 				},
 			)
 			diags.Append(diagObj...)
 			assertionElements[i] = assertionObj
 		}
-		assertionList, diagList := types.ListValue(
-			types.ObjectType{AttrTypes: map[string]attr.Type{
-				"type":     types.StringType,
-				"operator": types.StringType,
-				"value":    types.StringType,
-				"property": types.StringType,
-			}},
+		assertionSet, diagSet := types.SetValue(
+			types.ObjectType{AttrTypes: successAssertionAttrTypes()},
 			assertionElements,
 		)
-		diags.Append(diagList...)
-		data.SuccessAssertions = assertionList
+		diags.Append(diagSet...)
+		data.SuccessAssertions = assertionSet
 	} else {
-		data.SuccessAssertions = types.ListNull(types.ObjectType{AttrTypes: map[string]attr.Type{
-			"type":     types.StringType,
-			"operator": types.StringType,
-			"value":    types.StringType,
-			"property": types.StringType,
-		}})
+		data.SuccessAssertions = types.SetNull(types.ObjectType{AttrTypes: successAssertionAttrTypes()})
 	}
 
 	return diags
 }
 
+// priorQueryParamKeys returns the query_params keys of the http_request
+// currently held in data, or nil when query_params isn't set
+func priorQueryParamKeys(ctx context.Context, data *UptimeMonitorResourceModel, diags *diag.Diagnostics) []string {
+	if data.HTTPRequest.IsNull() || data.HTTPRequest.IsUnknown() {
+		return nil
+	}
+
+	var prior HTTPRequestModel
+	diags.Append(data.HTTPRequest.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+	if prior.QueryParams.IsNull() || prior.QueryParams.IsUnknown() {
+		return nil
+	}
+
+	keys := make([]string, 0, len(prior.QueryParams.Elements()))
+	for key := range prior.QueryParams.Elements() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// priorHasCookieHeader reports whether the http_request currently held in
+// data sets the Cookie header through headers rather than cookies
+func priorHasCookieHeader(ctx context.Context, data *UptimeMonitorResourceModel, diags *diag.Diagnostics) bool {
+	if data.HTTPRequest.IsNull() || data.HTTPRequest.IsUnknown() {
+		return false
+	}
+
+	var prior HTTPRequestModel
+	diags.Append(data.HTTPRequest.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+	if prior.Headers.IsNull() || prior.Headers.IsUnknown() {
+		return false
+	}
+
+	var headers []RequestHeaderModel
+	diags.Append(prior.Headers.ElementsAs(ctx, &headers, false)...)
+	for _, h := range headers {
+		if strings.EqualFold(h.Name.ValueString(), cookieHeaderName) {
+			return true
+		}
+	}
+	return false
+}
+
+// keepPlannedRequestURL keeps the planned http_request url in data when the
+// API stored an equivalent url, as the state after an apply must match the
+// plan
+func keepPlannedRequestURL(ctx context.Context, planned types.Object, data *UptimeMonitorResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if planned.IsNull() || planned.IsUnknown() || data.HTTPRequest.IsNull() || data.HTTPRequest.IsUnknown() {
+		return diags
+	}
+
+	var plannedReq, storedReq HTTPRequestModel
+	diags.Append(planned.As(ctx, &plannedReq, basetypes.ObjectAsOptions{})...)
+	diags.Append(data.HTTPRequest.As(ctx, &storedReq, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	storedReq.URL = planmodifiers.PlannedString(plannedReq.URL, storedReq.URL.ValueString(), planmodifiers.TrimSpace)
+	httpObj, diagObj := types.ObjectValueFrom(ctx, httpRequestAttrTypes(), storedReq)
+	diags.Append(diagObj...)
+	data.HTTPRequest = httpObj
+	return diags
+}
+
+// priorBodyFile returns the body_file of the http_request currently held in
+// data, which the API doesn't know about
+func priorBodyFile(ctx context.Context, data *UptimeMonitorResourceModel, diags *diag.Diagnostics) types.String {
+	if data.HTTPRequest.IsNull() || data.HTTPRequest.IsUnknown() {
+		return types.StringNull()
+	}
+
+	var prior HTTPRequestModel
+	diags.Append(data.HTTPRequest.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+	if prior.BodyFile.IsUnknown() {
+		return types.StringNull()
+	}
+	return prior.BodyFile
+}
+
+// httpRequestAttrTypes returns the attribute types of the http_request object
+func httpRequestAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"method":            types.StringType,
+		"url":               types.StringType,
+		"tls_skip_verify":   types.BoolType,
+		"tls":               types.ObjectType{AttrTypes: tlsAttrTypes()},
+		"body":              types.StringType,
+		"body_file":         types.StringType,
+		"follow_redirects":  types.BoolType,
+		"http_version":      types.StringType,
+		"user_agent_secret": types.StringType,
+		"query_params":      types.MapType{ElemType: types.StringType},
+		"cookies":           types.MapType{ElemType: types.StringType},
+		"headers":           types.SetType{ElemType: types.ObjectType{AttrTypes: requestHeaderAttrTypes()}},
+	}
+}
+
+// tcpRequestAttrTypes returns the attribute types of the tcp_request object
+func tcpRequestAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"host":            types.StringType,
+		"port":            types.StringType,
+		"connection":      types.StringType,
+		"tls_skip_verify": types.BoolType,
+		"tls":             types.ObjectType{AttrTypes: tlsAttrTypes()},
+	}
+}
+
+// tlsAttrTypes returns the attribute types of the tls object of requests
+func tlsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"min_version": types.StringType,
+		"server_name": types.StringType,
+	}
+}
+
+// tlsValue returns the tls object of request, or null when it has no TLS
+// settings
+func tlsValue(request client.MonitorRequest, diags *diag.Diagnostics) types.Object {
+	if request.TLSMinVersion == nil && request.TLSServerName == nil {
+		return types.ObjectNull(tlsAttrTypes())
+	}
+
+	tls, diagObj := types.ObjectValue(tlsAttrTypes(), map[string]attr.Value{
+		"min_version": types.StringPointerValue(request.TLSMinVersion),
+		"server_name": types.StringPointerValue(request.TLSServerName),
+	})
+	diags.Append(diagObj...)
+	return tls
+}
+
+// setTLSSettings sets the TLS settings of request from the tls object
+func setTLSSettings(ctx context.Context, tls types.Object, request *client.MonitorRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if tls.IsNull() || tls.IsUnknown() {
+		return diags
+	}
+
+	var settings TLSModel
+	diags.Append(tls.As(ctx, &settings, basetypes.ObjectAsOptions{})...)
+	if !settings.MinVersion.IsNull() {
+		request.TLSMinVersion = stringPtr(settings.MinVersion.ValueString())
+	}
+	if !settings.ServerName.IsNull() {
+		request.TLSServerName = stringPtr(settings.ServerName.ValueString())
+	}
+	return diags
+}
+
+// icmpRequestAttrTypes returns the attribute types of the icmp_request object
+func icmpRequestAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"host":         types.StringType,
+		"packet_count": types.Int64Type,
+		"packet_size":  types.Int64Type,
+	}
+}
+
+// dnsRequestAttrTypes returns the attribute types of the dns_request object
+func dnsRequestAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"host":        types.StringType,
+		"record_type": types.StringType,
+		"resolver":    types.StringType,
+	}
+}
+
+// heartbeatRequestAttrTypes returns the attribute types of the
+// heartbeat_request object
+func heartbeatRequestAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"period": types.Int64Type,
+		"grace":  types.Int64Type,
+	}
+}
+
+// requestHeaderAttrTypes returns the attribute types of an http_request header
+func requestHeaderAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":  types.StringType,
+		"value": types.StringType,
+	}
+}
+
+// successAssertionAttrTypes returns the attribute types of a success assertion
+func successAssertionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":     types.StringType,
+		"operator": types.StringType,
+		"value":    types.StringType,
+		"property": types.StringType,
+	}
+}
+
 // Helper functions
 func stringPtr(s string) *string {
 	return &s
@@ -293,3 +671,48 @@ func stringPtr(s string) *string {
 func boolPtr(b bool) *bool {
 	return &b
 }
+
+// assertionMatches reports whether an API assertion is the prior assertion p.
+// A null prior operator matches the default operator sent for its type.
+func assertionMatches(a client.SuccessAssertion, p SuccessAssertionModel) bool {
+	if a.Type != p.Type.ValueString() ||
+		!types.StringPointerValue(a.Value).Equal(p.Value) ||
+		!assertionPropertyValue(a).Equal(p.Property) {
+		return false
+	}
+
+	if p.Operator.IsNull() {
+		return a.Operator == nil || *a.Operator == defaultAssertionOperators[a.Type]
+	}
+	return a.Operator != nil && *a.Operator == p.Operator.ValueString()
+}
+
+// assertionPropertyValue returns the property of an API assertion. Only
+// response_header assertions have a property, the API may return an empty one
+// for other types.
+func assertionPropertyValue(a client.SuccessAssertion) types.String {
+	if a.Type != "response_header" && (a.Property == nil || *a.Property == "") {
+		return types.StringNull()
+	}
+	return types.StringPointerValue(a.Property)
+}
+
+// timestampValue returns the API timestamp, or the prior value when the API
+// omits it. Some endpoints don't return timestamps, and a known timestamp
+// must not be replaced by null on refresh.
+func timestampValue(ts *string, prior types.String) types.String {
+	if ts != nil {
+		return types.StringValue(*ts)
+	}
+	if prior.IsUnknown() {
+		return types.StringNull()
+	}
+	return prior
+}
+
+func boolValueOrDefault(b *bool, def bool) bool {
+	if b == nil {
+		return def
+	}
+	return *b
+}
@@ -0,0 +1,1188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+func TestUptimeMonitorRegionsNormalization(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{}
+
+	tests := []struct {
+		name       string
+		prior      []string
+		apiRegions []string
+		want       []string
+	}{
+		{
+			name:       "mixed-case API regions match configured regions",
+			prior:      []string{"na-usa-iad", "eu-deu-fra"},
+			apiRegions: []string{"NA-USA-IAD", "Eu-Deu-Fra"},
+			want:       []string{"na-usa-iad", "eu-deu-fra"},
+		},
+		{
+			name:       "configured casing is kept when API returns lowercase",
+			prior:      []string{"NA-USA-IAD"},
+			apiRegions: []string{"na-usa-iad"},
+			want:       []string{"NA-USA-IAD"},
+		},
+		{
+			name:       "reordered API regions match configured regions",
+			prior:      []string{"na-usa-iad", "EU-DEU-FRA", "as-jpn-hnd"},
+			apiRegions: []string{"as-jpn-hnd", "eu-deu-fra", "na-usa-iad"},
+			want:       []string{"na-usa-iad", "EU-DEU-FRA", "as-jpn-hnd"},
+		},
+		{
+			name:       "new API regions are lowercased",
+			prior:      nil,
+			apiRegions: []string{"AS-JPN-HND"},
+			want:       []string{"as-jpn-hnd"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := UptimeMonitorResourceModel{Regions: types.SetNull(types.StringType)}
+			if tt.prior != nil {
+				data.Regions = stringSetValue(t, tt.prior)
+			}
+
+			monitor := &client.Monitor{
+				Name:     "test",
+				Protocol: "tcp",
+				Regions:  tt.apiRegions,
+			}
+
+			if diags := r.apiToTerraformModel(ctx, monitor, &data); diags.HasError() {
+				t.Fatalf("apiToTerraformModel() unexpected diagnostics: %v", diags)
+			}
+
+			if want := stringSetValue(t, tt.want); !data.Regions.Equal(want) {
+				t.Errorf("regions = %v, want %v", data.Regions, want)
+			}
+		})
+	}
+
+	t.Run("empty API regions keep prior regions", func(t *testing.T) {
+		data := UptimeMonitorResourceModel{Regions: stringSetValue(t, []string{"na-usa-iad", "eu-deu-fra"})}
+		monitor := &client.Monitor{
+			Name:     "test",
+			Protocol: "tcp",
+			Interval: 60,
+			Regions:  []string{},
+		}
+
+		diags := r.apiToTerraformModel(ctx, monitor, &data)
+		if diags.HasError() {
+			t.Fatalf("apiToTerraformModel() unexpected diagnostics: %v", diags)
+		}
+		if diags.WarningsCount() != 1 {
+			t.Errorf("expected a warning for the missing regions, got %v", diags)
+		}
+		if want := stringSetValue(t, []string{"na-usa-iad", "eu-deu-fra"}); !data.Regions.Equal(want) {
+			t.Errorf("regions = %v, want %v", data.Regions, want)
+		}
+		// The estimate counts the kept regions rather than the empty API ones
+		if data.EstimatedChecks.ValueInt64() != 86400 {
+			t.Errorf("estimated_checks_per_month = %d, want 86400", data.EstimatedChecks.ValueInt64())
+		}
+	})
+
+	t.Run("regions are sent lowercase", func(t *testing.T) {
+		tcpRequest, diags := types.ObjectValueFrom(ctx, tcpRequestAttrTypes(), TCPRequestModel{
+			Host:          types.StringValue("example.com"),
+			Port:          types.StringValue("443"),
+			Connection:    types.StringValue("tls"),
+			TLSSkipVerify: types.BoolValue(false),
+			TLS:           types.ObjectNull(tlsAttrTypes()),
+		})
+		if diags.HasError() {
+			t.Fatalf("failed to build tcp_request: %v", diags)
+		}
+
+		data := UptimeMonitorResourceModel{
+			Protocol:          types.StringValue("tcp"),
+			Regions:           stringSetValue(t, []string{"NA-USA-IAD"}),
+			TCPRequest:        tcpRequest,
+			ICMPRequest:       types.ObjectNull(icmpRequestAttrTypes()),
+			DNSRequest:        types.ObjectNull(dnsRequestAttrTypes()),
+			HeartbeatRequest:  types.ObjectNull(heartbeatRequestAttrTypes()),
+			SuccessAssertions: types.SetNull(types.ObjectType{AttrTypes: successAssertionAttrTypes()}),
+		}
+
+		monitor, diags := r.terraformToAPIModel(ctx, &data)
+		if diags.HasError() {
+			t.Fatalf("terraformToAPIModel() unexpected diagnostics: %v", diags)
+		}
+		if len(monitor.Regions) != 1 || monitor.Regions[0] != "na-usa-iad" {
+			t.Errorf("regions = %v, want [na-usa-iad]", monitor.Regions)
+		}
+	})
+}
+
+func TestUptimeMonitorICMPRequest(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{}
+
+	icmpRequest, diags := types.ObjectValueFrom(ctx, icmpRequestAttrTypes(), ICMPRequestModel{
+		Host:        types.StringValue("10.0.0.1"),
+		PacketCount: types.Int64Value(3),
+		PacketSize:  types.Int64Value(64),
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to build icmp_request: %v", diags)
+	}
+
+	data := UptimeMonitorResourceModel{
+		Protocol:          types.StringValue("icmp"),
+		Regions:           stringSetValue(t, []string{"na-usa-iad"}),
+		HTTPRequest:       types.ObjectNull(httpRequestAttrTypes()),
+		TCPRequest:        types.ObjectNull(tcpRequestAttrTypes()),
+		ICMPRequest:       icmpRequest,
+		DNSRequest:        types.ObjectNull(dnsRequestAttrTypes()),
+		HeartbeatRequest:  types.ObjectNull(heartbeatRequestAttrTypes()),
+		SuccessAssertions: types.SetNull(types.ObjectType{AttrTypes: successAssertionAttrTypes()}),
+	}
+
+	monitor, diags := r.terraformToAPIModel(ctx, &data)
+	if diags.HasError() {
+		t.Fatalf("terraformToAPIModel() unexpected diagnostics: %v", diags)
+	}
+
+	request := monitor.Request
+	if request.Host == nil || *request.Host != "10.0.0.1" {
+		t.Errorf("host = %v, want 10.0.0.1", request.Host)
+	}
+	if request.PacketCount == nil || *request.PacketCount != 3 || request.PacketSize == nil || *request.PacketSize != 64 {
+		t.Errorf("packet_count = %v, packet_size = %v, want 3 and 64", request.PacketCount, request.PacketSize)
+	}
+	if request.URL != nil || request.Port != nil {
+		t.Errorf("request = %+v, want only ICMP fields", request)
+	}
+
+	// The request reads back to the configured value
+	if diags := r.apiToTerraformModel(ctx, monitor, &data); diags.HasError() {
+		t.Fatalf("apiToTerraformModel() unexpected diagnostics: %v", diags)
+	}
+	if !data.ICMPRequest.Equal(icmpRequest) {
+		t.Errorf("icmp_request = %v, want %v", data.ICMPRequest, icmpRequest)
+	}
+}
+
+func TestUptimeMonitorTLSSettings(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{}
+
+	tls, diags := types.ObjectValueFrom(ctx, tlsAttrTypes(), TLSModel{
+		MinVersion: types.StringValue("1.2"),
+		ServerName: types.StringValue("example.com"),
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to build tls: %v", diags)
+	}
+	tcpRequest, diags := types.ObjectValueFrom(ctx, tcpRequestAttrTypes(), TCPRequestModel{
+		Host:          types.StringValue("example.com"),
+		Port:          types.StringValue("443"),
+		Connection:    types.StringValue("tls"),
+		TLSSkipVerify: types.BoolValue(false),
+		TLS:           tls,
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to build tcp_request: %v", diags)
+	}
+
+	data := UptimeMonitorResourceModel{
+		Protocol:          types.StringValue("tcp"),
+		Regions:           stringSetValue(t, []string{"na-usa-iad"}),
+		HTTPRequest:       types.ObjectNull(httpRequestAttrTypes()),
+		TCPRequest:        tcpRequest,
+		ICMPRequest:       types.ObjectNull(icmpRequestAttrTypes()),
+		DNSRequest:        types.ObjectNull(dnsRequestAttrTypes()),
+		HeartbeatRequest:  types.ObjectNull(heartbeatRequestAttrTypes()),
+		SuccessAssertions: types.SetNull(types.ObjectType{AttrTypes: successAssertionAttrTypes()}),
+	}
+
+	monitor, diags := r.terraformToAPIModel(ctx, &data)
+	if diags.HasError() {
+		t.Fatalf("terraformToAPIModel() unexpected diagnostics: %v", diags)
+	}
+
+	request := monitor.Request
+	if request.TLSMinVersion == nil || *request.TLSMinVersion != "1.2" {
+		t.Errorf("tls_min_version = %v, want 1.2", request.TLSMinVersion)
+	}
+	if request.TLSServerName == nil || *request.TLSServerName != "example.com" {
+		t.Errorf("tls_server_name = %v, want example.com", request.TLSServerName)
+	}
+
+	// The tls object reads back to the configured value
+	if diags := r.apiToTerraformModel(ctx, monitor, &data); diags.HasError() {
+		t.Fatalf("apiToTerraformModel() unexpected diagnostics: %v", diags)
+	}
+	if !data.TCPRequest.Equal(tcpRequest) {
+		t.Errorf("tcp_request = %v, want %v", data.TCPRequest, tcpRequest)
+	}
+
+	// A request without TLS settings reads back without a tls object
+	monitor.Request.TLSMinVersion = nil
+	monitor.Request.TLSServerName = nil
+	if diags := r.apiToTerraformModel(ctx, monitor, &data); diags.HasError() {
+		t.Fatalf("apiToTerraformModel() unexpected diagnostics: %v", diags)
+	}
+	var tcpReq TCPRequestModel
+	if diags := data.TCPRequest.As(ctx, &tcpReq, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("failed to read tcp_request: %v", diags)
+	}
+	if !tcpReq.TLS.IsNull() {
+		t.Errorf("tls = %v, want null", tcpReq.TLS)
+	}
+}
+
+func TestUptimeMonitorDNSRequest(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{}
+
+	dnsRequest, diags := types.ObjectValueFrom(ctx, dnsRequestAttrTypes(), DNSRequestModel{
+		Host:       types.StringValue("example.com"),
+		RecordType: types.StringValue("MX"),
+		Resolver:   types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to build dns_request: %v", diags)
+	}
+
+	assertions, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: successAssertionAttrTypes()}, []SuccessAssertionModel{
+		{
+			Type:     types.StringValue("record_value"),
+			Operator: types.StringNull(),
+			Value:    types.StringValue("mx.example.com"),
+			Property: types.StringNull(),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	data := UptimeMonitorResourceModel{
+		Protocol:          types.StringValue("dns"),
+		Regions:           stringSetValue(t, []string{"na-usa-iad"}),
+		HTTPRequest:       types.ObjectNull(httpRequestAttrTypes()),
+		TCPRequest:        types.ObjectNull(tcpRequestAttrTypes()),
+		ICMPRequest:       types.ObjectNull(icmpRequestAttrTypes()),
+		DNSRequest:        dnsRequest,
+		HeartbeatRequest:  types.ObjectNull(heartbeatRequestAttrTypes()),
+		SuccessAssertions: assertions,
+	}
+
+	monitor, diags := r.terraformToAPIModel(ctx, &data)
+	if diags.HasError() {
+		t.Fatalf("terraformToAPIModel() unexpected diagnostics: %v", diags)
+	}
+
+	request := monitor.Request
+	if request.Host == nil || *request.Host != "example.com" || request.RecordType == nil || *request.RecordType != "MX" {
+		t.Errorf("host = %v, record_type = %v, want example.com and MX", request.Host, request.RecordType)
+	}
+	if request.Resolver != nil || request.URL != nil || request.PacketCount != nil {
+		t.Errorf("request = %+v, want only DNS fields", request)
+	}
+	if got := monitor.SuccessAssertions[0].Operator; got == nil || *got != "contains" {
+		t.Errorf("sent record_value operator = %v, want contains", got)
+	}
+
+	// The request and assertions read back to the configured values
+	if diags := r.apiToTerraformModel(ctx, monitor, &data); diags.HasError() {
+		t.Fatalf("apiToTerraformModel() unexpected diagnostics: %v", diags)
+	}
+	if !data.DNSRequest.Equal(dnsRequest) {
+		t.Errorf("dns_request = %v, want %v", data.DNSRequest, dnsRequest)
+	}
+	if !data.SuccessAssertions.Equal(assertions) {
+		t.Errorf("success_assertions = %v, want %v", data.SuccessAssertions, assertions)
+	}
+}
+
+func TestUptimeMonitorHeartbeatRequest(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{}
+
+	heartbeatRequest, diags := types.ObjectValueFrom(ctx, heartbeatRequestAttrTypes(), HeartbeatRequestModel{
+		Period: types.Int64Value(3600),
+		Grace:  types.Int64Value(300),
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to build heartbeat_request: %v", diags)
+	}
+
+	data := UptimeMonitorResourceModel{
+		Protocol:          types.StringValue("heartbeat"),
+		Regions:           stringSetValue(t, []string{"na-usa-iad"}),
+		HTTPRequest:       types.ObjectNull(httpRequestAttrTypes()),
+		TCPRequest:        types.ObjectNull(tcpRequestAttrTypes()),
+		ICMPRequest:       types.ObjectNull(icmpRequestAttrTypes()),
+		DNSRequest:        types.ObjectNull(dnsRequestAttrTypes()),
+		HeartbeatRequest:  heartbeatRequest,
+		SuccessAssertions: types.SetNull(types.ObjectType{AttrTypes: successAssertionAttrTypes()}),
+		PingURL:           types.StringUnknown(),
+	}
+
+	monitor, diags := r.terraformToAPIModel(ctx, &data)
+	if diags.HasError() {
+		t.Fatalf("terraformToAPIModel() unexpected diagnostics: %v", diags)
+	}
+
+	request := monitor.Request
+	if request.Period == nil || *request.Period != 3600 || request.Grace == nil || *request.Grace != 300 {
+		t.Errorf("period = %v, grace = %v, want 3600 and 300", request.Period, request.Grace)
+	}
+	if request.Host != nil || request.URL != nil {
+		t.Errorf("request = %+v, want only heartbeat fields", request)
+	}
+
+	// The generated ping URL is read back with the request
+	pingURL := "https://ping.example.com/abc123"
+	monitor.PingURL = &pingURL
+	if diags := r.apiToTerraformModel(ctx, monitor, &data); diags.HasError() {
+		t.Fatalf("apiToTerraformModel() unexpected diagnostics: %v", diags)
+	}
+	if !data.HeartbeatRequest.Equal(heartbeatRequest) {
+		t.Errorf("heartbeat_request = %v, want %v", data.HeartbeatRequest, heartbeatRequest)
+	}
+	if !data.PingURL.Equal(types.StringValue(pingURL)) {
+		t.Errorf("ping_url = %v, want %q", data.PingURL, pingURL)
+	}
+
+	// A response without the ping URL keeps the known one
+	monitor.PingURL = nil
+	if diags := r.apiToTerraformModel(ctx, monitor, &data); diags.HasError() {
+		t.Fatalf("apiToTerraformModel() unexpected diagnostics: %v", diags)
+	}
+	if !data.PingURL.Equal(types.StringValue(pingURL)) {
+		t.Errorf("ping_url = %v, want the prior %q", data.PingURL, pingURL)
+	}
+}
+
+func stringListValue(t *testing.T, values []string) types.List {
+	t.Helper()
+
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+
+	list, diags := types.ListValue(types.StringType, elements)
+	if diags.HasError() {
+		t.Fatalf("failed to build list: %v", diags)
+	}
+	return list
+}
+
+func stringSetValue(t *testing.T, values []string) types.Set {
+	t.Helper()
+
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+
+	set, diags := types.SetValue(types.StringType, elements)
+	if diags.HasError() {
+		t.Fatalf("failed to build set: %v", diags)
+	}
+	return set
+}
+
+// withoutTimeouts wraps a model in the resource data with unset timeouts
+func withoutTimeouts(model UptimeMonitorResourceModel) *uptimeMonitorResourceData {
+	return &uptimeMonitorResourceData{
+		UptimeMonitorResourceModel: model,
+		Timeouts:                   types.ObjectNull(timeoutsAttrTypes()),
+	}
+}
+
+func TestUptimeMonitorResolvedIPFamily(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{}
+
+	tests := []struct {
+		name     string
+		family   *string
+		wantNull bool
+	}{
+		{
+			name:   "family reported by last check",
+			family: stringPtr("ipv6"),
+		},
+		{
+			name:     "family absent before first check",
+			family:   nil,
+			wantNull: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := UptimeMonitorResourceModel{Regions: types.SetNull(types.StringType)}
+			monitor := &client.Monitor{
+				Name:             "test",
+				Protocol:         "tcp",
+				ResolvedIPFamily: tt.family,
+			}
+
+			if diags := r.apiToTerraformModel(ctx, monitor, &data); diags.HasError() {
+				t.Fatalf("apiToTerraformModel() unexpected diagnostics: %v", diags)
+			}
+
+			if data.ResolvedIPFamily.IsNull() != tt.wantNull {
+				t.Fatalf("resolved_ip_family null = %v, want %v", data.ResolvedIPFamily.IsNull(), tt.wantNull)
+			}
+			if !tt.wantNull && data.ResolvedIPFamily.ValueString() != *tt.family {
+				t.Errorf("resolved_ip_family = %v, want %v", data.ResolvedIPFamily.ValueString(), *tt.family)
+			}
+		})
+	}
+}
+
+func TestUptimeMonitorStatus(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{}
+
+	tests := []struct {
+		name     string
+		status   *string
+		wantNull bool
+	}{
+		{
+			name:   "status reported by the API",
+			status: stringPtr("down"),
+		},
+		{
+			name:     "status absent from response",
+			status:   nil,
+			wantNull: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := UptimeMonitorResourceModel{Regions: types.SetNull(types.StringType)}
+			monitor := &client.Monitor{
+				Name:     "test",
+				Protocol: "tcp",
+				Status:   tt.status,
+			}
+
+			if diags := r.apiToTerraformModel(ctx, monitor, &data); diags.HasError() {
+				t.Fatalf("apiToTerraformModel() unexpected diagnostics: %v", diags)
+			}
+
+			if data.Status.IsNull() != tt.wantNull {
+				t.Fatalf("status null = %v, want %v", data.Status.IsNull(), tt.wantNull)
+			}
+			if !tt.wantNull && data.Status.ValueString() != *tt.status {
+				t.Errorf("status = %v, want %v", data.Status.ValueString(), *tt.status)
+			}
+		})
+	}
+}
+
+func TestUptimeMonitorMixedRequestFields(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{}
+
+	// A request echoing the HTTP, TCP and ICMP fields
+	packetCount := 5
+	mixedRequest := client.MonitorRequest{
+		Method:      stringPtr("GET"),
+		URL:         stringPtr("https://example.com"),
+		Host:        stringPtr("example.com"),
+		Port:        stringPtr("443"),
+		Connection:  stringPtr("tls"),
+		PacketCount: &packetCount,
+	}
+
+	tests := []struct {
+		name     string
+		protocol string
+		wantHTTP bool
+		wantTCP  bool
+		wantICMP bool
+	}{
+		{
+			name:     "http protocol ignores tcp fields",
+			protocol: "http",
+			wantHTTP: true,
+		},
+		{
+			name:     "tcp protocol ignores http fields",
+			protocol: "tcp",
+			wantTCP:  true,
+		},
+		{
+			name:     "icmp protocol ignores http and tcp fields",
+			protocol: "icmp",
+			wantICMP: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := UptimeMonitorResourceModel{Regions: types.SetNull(types.StringType)}
+			monitor := &client.Monitor{
+				Name:     "test",
+				Protocol: tt.protocol,
+				Request:  mixedRequest,
+			}
+
+			if diags := r.apiToTerraformModel(ctx, monitor, &data); diags.HasError() {
+				t.Fatalf("apiToTerraformModel() unexpected diagnostics: %v", diags)
+			}
+
+			if data.HTTPRequest.IsNull() == tt.wantHTTP {
+				t.Errorf("http_request null = %v, want %v", data.HTTPRequest.IsNull(), !tt.wantHTTP)
+			}
+			if data.TCPRequest.IsNull() == tt.wantTCP {
+				t.Errorf("tcp_request null = %v, want %v", data.TCPRequest.IsNull(), !tt.wantTCP)
+			}
+			if data.ICMPRequest.IsNull() == tt.wantICMP {
+				t.Errorf("icmp_request null = %v, want %v", data.ICMPRequest.IsNull(), !tt.wantICMP)
+			}
+
+			if tt.wantHTTP {
+				var httpReq HTTPRequestModel
+				if diags := data.HTTPRequest.As(ctx, &httpReq, basetypes.ObjectAsOptions{}); diags.HasError() {
+					t.Fatalf("failed to read http_request: %v", diags)
+				}
+				if httpReq.URL.ValueString() != "https://example.com" {
+					t.Errorf("url = %v, want https://example.com", httpReq.URL)
+				}
+				if !httpReq.FollowRedirects.ValueBool() || httpReq.TLSSkipVerify.ValueBool() {
+					t.Errorf("follow_redirects = %v, tls_skip_verify = %v, want schema defaults",
+						httpReq.FollowRedirects, httpReq.TLSSkipVerify)
+				}
+			}
+
+			if tt.wantTCP {
+				var tcpReq TCPRequestModel
+				if diags := data.TCPRequest.As(ctx, &tcpReq, basetypes.ObjectAsOptions{}); diags.HasError() {
+					t.Fatalf("failed to read tcp_request: %v", diags)
+				}
+				if tcpReq.Host.ValueString() != "example.com" || tcpReq.Port.ValueString() != "443" {
+					t.Errorf("host = %v, port = %v, want example.com:443", tcpReq.Host, tcpReq.Port)
+				}
+			}
+
+			if tt.wantICMP {
+				var icmpReq ICMPRequestModel
+				if diags := data.ICMPRequest.As(ctx, &icmpReq, basetypes.ObjectAsOptions{}); diags.HasError() {
+					t.Fatalf("failed to read icmp_request: %v", diags)
+				}
+				if icmpReq.Host.ValueString() != "example.com" || icmpReq.PacketCount.ValueInt64() != 5 {
+					t.Errorf("host = %v, packet_count = %v, want example.com and 5", icmpReq.Host, icmpReq.PacketCount)
+				}
+				if !icmpReq.PacketSize.IsNull() {
+					t.Errorf("packet_size = %v, want null", icmpReq.PacketSize)
+				}
+			}
+		})
+	}
+}
+
+func TestUptimeMonitorResourceCreate(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeMonitorAPI()
+	r := &UptimeMonitorResource{client: fake}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	httpReq, diags := types.ObjectValueFrom(ctx, httpRequestAttrTypes(), HTTPRequestModel{
+		Method:          types.StringValue("GET"),
+		URL:             types.StringValue("https://example.com/health"),
+		TLSSkipVerify:   types.BoolValue(false),
+		TLS:             types.ObjectNull(tlsAttrTypes()),
+		Body:            types.StringNull(),
+		FollowRedirects: types.BoolValue(true),
+		HTTPVersion:     types.StringValue("2"),
+		UserAgentSecret: types.StringNull(),
+		QueryParams:     types.MapValueMust(types.StringType, map[string]attr.Value{"source": types.StringValue("phare")}),
+		Cookies:         types.MapValueMust(types.StringType, map[string]attr.Value{"session": types.StringValue("abc")}),
+		Headers:         types.SetNull(types.ObjectType{AttrTypes: requestHeaderAttrTypes()}),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags = plan.Set(ctx, withoutTimeouts(UptimeMonitorResourceModel{
+		ID:                    types.StringUnknown(),
+		Name:                  types.StringValue("Website"),
+		Protocol:              types.StringValue("http"),
+		HTTPRequest:           httpReq,
+		TCPRequest:            types.ObjectNull(tcpRequestAttrTypes()),
+		ICMPRequest:           types.ObjectNull(icmpRequestAttrTypes()),
+		DNSRequest:            types.ObjectNull(dnsRequestAttrTypes()),
+		HeartbeatRequest:      types.ObjectNull(heartbeatRequestAttrTypes()),
+		Interval:              types.Int64Value(60),
+		Timeout:               types.Int64Value(5000),
+		IncidentConfirmations: types.Int64Value(1),
+		RecoveryConfirmations: types.Int64Value(1),
+		Regions:               stringSetValue(t, []string{"NA-USA-IAD"}),
+		SuccessAssertions:     types.SetNull(types.ObjectType{AttrTypes: successAssertionAttrTypes()}),
+		Paused:                types.BoolValue(true),
+		ResolvedIPFamily:      types.StringUnknown(),
+		EstimatedChecks:       types.Int64Unknown(),
+		CreatedAt:             types.StringUnknown(),
+		UpdatedAt:             types.StringUnknown(),
+		Tags:                  types.MapNull(types.StringType),
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	// Paused monitors are created paused rather than paused after creation
+	wantCalls := []string{"CreateMonitor(1)", "GetMonitor(1)"}
+	if !slices.Equal(fake.calls, wantCalls) {
+		t.Errorf("calls = %v, want %v", fake.calls, wantCalls)
+	}
+
+	sent := fake.monitors[1]
+	if got := *sent.Request.URL; got != "https://example.com/health?source=phare" {
+		t.Errorf("sent url = %q, want query_params appended", got)
+	}
+	if len(sent.Request.Headers) != 1 || sent.Request.Headers[0] != (client.RequestHeader{Name: "Cookie", Value: "session=abc"}) {
+		t.Errorf("sent headers = %v, want cookies sent as a Cookie header", sent.Request.Headers)
+	}
+	if !slices.Equal(sent.Regions, []string{"na-usa-iad"}) {
+		t.Errorf("sent regions = %v, want lowercase regions", sent.Regions)
+	}
+	if sent.Request.HTTPVersion == nil || *sent.Request.HTTPVersion != "2" {
+		t.Errorf("sent http_version = %v, want 2", sent.Request.HTTPVersion)
+	}
+
+	var state uptimeMonitorResourceData
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if state.ID.ValueString() != "1" {
+		t.Errorf("id = %q, want 1", state.ID.ValueString())
+	}
+	if !state.Paused.ValueBool() {
+		t.Error("expected monitor to be paused")
+	}
+	if state.CreatedAt.ValueString() != "2024-01-01T00:00:00Z" {
+		t.Errorf("created_at = %q, want value read back from the API", state.CreatedAt.ValueString())
+	}
+
+	if state.EstimatedChecks.ValueInt64() != 43200 {
+		t.Errorf("estimated_checks_per_month = %d, want 43200", state.EstimatedChecks.ValueInt64())
+	}
+
+	var stateReq HTTPRequestModel
+	resp.Diagnostics.Append(state.HTTPRequest.As(ctx, &stateReq, basetypes.ObjectAsOptions{})...)
+	if stateReq.HTTPVersion.ValueString() != "2" {
+		t.Errorf("state http_version = %q, want 2", stateReq.HTTPVersion.ValueString())
+	}
+	if stateReq.URL.ValueString() != "https://example.com/health" {
+		t.Errorf("state url = %q, want query_params stripped", stateReq.URL.ValueString())
+	}
+	if !stateReq.Headers.IsNull() {
+		t.Errorf("state headers = %v, want the Cookie header moved into cookies", stateReq.Headers)
+	}
+	if !stateReq.Cookies.Equal(types.MapValueMust(types.StringType, map[string]attr.Value{"session": types.StringValue("abc")})) {
+		t.Errorf("state cookies = %v", stateReq.Cookies)
+	}
+	if !stateReq.QueryParams.Equal(types.MapValueMust(types.StringType, map[string]attr.Value{"source": types.StringValue("phare")})) {
+		t.Errorf("state query_params = %v", stateReq.QueryParams)
+	}
+}
+
+func TestUptimeMonitorHeadersOrder(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeMonitorAPI()
+	r := &UptimeMonitorResource{client: fake}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	headers, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: requestHeaderAttrTypes()}, []RequestHeaderModel{
+		{Name: types.StringValue("Accept"), Value: types.StringValue("application/json")},
+		{Name: types.StringValue("X-Api-Key"), Value: types.StringValue("secret")},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	httpReq, diags := types.ObjectValueFrom(ctx, httpRequestAttrTypes(), HTTPRequestModel{
+		Method:          types.StringValue("GET"),
+		URL:             types.StringValue("https://example.com/health"),
+		TLSSkipVerify:   types.BoolValue(false),
+		TLS:             types.ObjectNull(tlsAttrTypes()),
+		Body:            types.StringNull(),
+		BodyFile:        types.StringNull(),
+		FollowRedirects: types.BoolValue(true),
+		HTTPVersion:     types.StringValue(httpVersionAuto),
+		UserAgentSecret: types.StringNull(),
+		QueryParams:     types.MapNull(types.StringType),
+		Cookies:         types.MapNull(types.StringType),
+		Headers:         headers,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags = plan.Set(ctx, withoutTimeouts(UptimeMonitorResourceModel{
+		ID:                    types.StringUnknown(),
+		Name:                  types.StringValue("Website"),
+		Protocol:              types.StringValue("http"),
+		HTTPRequest:           httpReq,
+		TCPRequest:            types.ObjectNull(tcpRequestAttrTypes()),
+		ICMPRequest:           types.ObjectNull(icmpRequestAttrTypes()),
+		DNSRequest:            types.ObjectNull(dnsRequestAttrTypes()),
+		HeartbeatRequest:      types.ObjectNull(heartbeatRequestAttrTypes()),
+		Interval:              types.Int64Value(60),
+		Timeout:               types.Int64Value(5000),
+		IncidentConfirmations: types.Int64Value(1),
+		RecoveryConfirmations: types.Int64Value(1),
+		Regions:               stringSetValue(t, []string{"na-usa-iad"}),
+		SuccessAssertions:     types.SetNull(types.ObjectType{AttrTypes: successAssertionAttrTypes()}),
+		Paused:                types.BoolValue(false),
+		ResolvedIPFamily:      types.StringUnknown(),
+		EstimatedChecks:       types.Int64Unknown(),
+		CreatedAt:             types.StringUnknown(),
+		UpdatedAt:             types.StringUnknown(),
+		Tags:                  types.MapNull(types.StringType),
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	createResp := &resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", createResp.Diagnostics)
+	}
+
+	// The API returns the headers in reverse order
+	monitor := fake.monitors[1]
+	slices.Reverse(monitor.Request.Headers)
+	fake.monitors[1] = monitor
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var state uptimeMonitorResourceData
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &state)...)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", readResp.Diagnostics)
+	}
+	if !state.HTTPRequest.Equal(httpReq) {
+		t.Errorf("http_request = %v, want the planned %v", state.HTTPRequest, httpReq)
+	}
+}
+
+func TestUptimeMonitorMissingTimestamps(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{}
+
+	tests := []struct {
+		name          string
+		priorCreated  types.String
+		priorUpdated  types.String
+		wantCreatedAt types.String
+		wantUpdatedAt types.String
+	}{
+		{
+			name:          "known timestamps are kept on refresh",
+			priorCreated:  types.StringValue("2024-01-01T00:00:00Z"),
+			priorUpdated:  types.StringValue("2024-01-02T00:00:00Z"),
+			wantCreatedAt: types.StringValue("2024-01-01T00:00:00Z"),
+			wantUpdatedAt: types.StringValue("2024-01-02T00:00:00Z"),
+		},
+		{
+			name:          "unknown timestamps become null after apply",
+			priorCreated:  types.StringUnknown(),
+			priorUpdated:  types.StringUnknown(),
+			wantCreatedAt: types.StringNull(),
+			wantUpdatedAt: types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := 1
+			monitor := &client.Monitor{ID: &id, Name: "api", Protocol: "http", Interval: 60}
+
+			data := UptimeMonitorResourceModel{
+				Regions:     types.SetNull(types.StringType),
+				HTTPRequest: types.ObjectNull(httpRequestAttrTypes()),
+				CreatedAt:   tt.priorCreated,
+				UpdatedAt:   tt.priorUpdated,
+			}
+			if diags := r.apiToTerraformModel(ctx, monitor, &data); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !data.CreatedAt.Equal(tt.wantCreatedAt) {
+				t.Errorf("created_at = %v, want %v", data.CreatedAt, tt.wantCreatedAt)
+			}
+			if !data.UpdatedAt.Equal(tt.wantUpdatedAt) {
+				t.Errorf("updated_at = %v, want %v", data.UpdatedAt, tt.wantUpdatedAt)
+			}
+		})
+	}
+}
+
+func TestUptimeMonitorResourceReadNotFound(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{client: newFakeMonitorAPI()}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, withoutTimeouts(UptimeMonitorResourceModel{
+		ID:                types.StringValue("1"),
+		Name:              types.StringValue("Deleted"),
+		Protocol:          types.StringValue("http"),
+		HTTPRequest:       types.ObjectNull(httpRequestAttrTypes()),
+		TCPRequest:        types.ObjectNull(tcpRequestAttrTypes()),
+		ICMPRequest:       types.ObjectNull(icmpRequestAttrTypes()),
+		DNSRequest:        types.ObjectNull(dnsRequestAttrTypes()),
+		HeartbeatRequest:  types.ObjectNull(heartbeatRequestAttrTypes()),
+		Regions:           stringSetValue(t, []string{"na-usa-iad"}),
+		SuccessAssertions: types.SetNull(types.ObjectType{AttrTypes: successAssertionAttrTypes()}),
+		Tags:              types.MapNull(types.StringType),
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// The monitor doesn't exist in the fake, as if deleted outside Terraform
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("expected the monitor to be removed from state")
+	}
+}
+
+func TestUptimeMonitorResourceUpdateClearsAssertions(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeMonitorAPI()
+	r := &UptimeMonitorResource{client: fake}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	id := 1
+	fake.monitors[id] = client.Monitor{
+		ID:       &id,
+		Name:     "Database",
+		Protocol: "tcp",
+		Request: client.MonitorRequest{
+			Host: stringPtr("db.example.com"),
+			Port: stringPtr("5432"),
+		},
+		Regions: []string{"na-usa-iad"},
+		SuccessAssertions: []client.SuccessAssertion{
+			{Type: "response_time", Operator: stringPtr("less_than"), Value: stringPtr("500")},
+		},
+	}
+
+	tcpReq, diags := types.ObjectValueFrom(ctx, tcpRequestAttrTypes(), TCPRequestModel{
+		Host:          types.StringValue("db.example.com"),
+		Port:          types.StringValue("5432"),
+		Connection:    types.StringValue("plain"),
+		TLSSkipVerify: types.BoolValue(false),
+		TLS:           types.ObjectNull(tlsAttrTypes()),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	model := UptimeMonitorResourceModel{
+		ID:               types.StringValue("1"),
+		Name:             types.StringValue("Database"),
+		Protocol:         types.StringValue("tcp"),
+		HTTPRequest:      types.ObjectNull(httpRequestAttrTypes()),
+		TCPRequest:       tcpReq,
+		ICMPRequest:      types.ObjectNull(icmpRequestAttrTypes()),
+		DNSRequest:       types.ObjectNull(dnsRequestAttrTypes()),
+		HeartbeatRequest: types.ObjectNull(heartbeatRequestAttrTypes()),
+		Regions:          stringSetValue(t, []string{"na-usa-iad"}),
+		SuccessAssertions: successAssertionSetValue(t, SuccessAssertionModel{
+			Type:     types.StringValue("response_time"),
+			Operator: types.StringValue("less_than"),
+			Value:    types.StringValue("500"),
+			Property: types.StringNull(),
+		}),
+		Tags: types.MapNull(types.StringType),
+	}
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, withoutTimeouts(model)); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// The assertions were removed from the configuration
+	model.SuccessAssertions = types.SetUnknown(types.ObjectType{AttrTypes: successAssertionAttrTypes()})
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := plan.Set(ctx, withoutTimeouts(model)); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if sent := fake.monitors[1].SuccessAssertions; sent != nil {
+		t.Errorf("sent success_assertions = %v, want unset", sent)
+	}
+
+	var got uptimeMonitorResourceData
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !got.SuccessAssertions.IsNull() {
+		t.Errorf("success_assertions = %v, want cleared", got.SuccessAssertions)
+	}
+}
+
+func TestUptimeMonitorDefaultAssertionOperator(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{}
+
+	assertions, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: successAssertionAttrTypes()}, []SuccessAssertionModel{
+		{
+			Type:     types.StringValue("status_code"),
+			Operator: types.StringNull(),
+			Value:    types.StringValue("2xx"),
+			Property: types.StringNull(),
+		},
+		{
+			Type:     types.StringValue("response_body"),
+			Operator: types.StringValue("equals"),
+			Value:    types.StringValue("ok"),
+			Property: types.StringNull(),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	tcpReq, diags := types.ObjectValueFrom(ctx, tcpRequestAttrTypes(), TCPRequestModel{
+		Host:          types.StringValue("db.example.com"),
+		Port:          types.StringValue("5432"),
+		Connection:    types.StringValue("plain"),
+		TLSSkipVerify: types.BoolValue(false),
+		TLS:           types.ObjectNull(tlsAttrTypes()),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	data := UptimeMonitorResourceModel{
+		Protocol:          types.StringValue("tcp"),
+		TCPRequest:        tcpReq,
+		ICMPRequest:       types.ObjectNull(icmpRequestAttrTypes()),
+		DNSRequest:        types.ObjectNull(dnsRequestAttrTypes()),
+		HeartbeatRequest:  types.ObjectNull(heartbeatRequestAttrTypes()),
+		HTTPRequest:       types.ObjectNull(httpRequestAttrTypes()),
+		Regions:           stringSetValue(t, []string{"na-usa-iad"}),
+		SuccessAssertions: assertions,
+	}
+
+	monitor, diags := r.terraformToAPIModel(ctx, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := monitor.SuccessAssertions[0].Operator; got == nil || *got != "in" {
+		t.Fatalf("sent status_code operator = %v, want in", got)
+	}
+
+	// The default operator read back from the API doesn't produce a diff
+	diags = r.apiToTerraformModel(ctx, monitor, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !data.SuccessAssertions.Equal(assertions) {
+		t.Errorf("success_assertions = %v, want %v", data.SuccessAssertions, assertions)
+	}
+}
+
+func TestUptimeMonitorResponseTimeAssertion(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{}
+
+	assertions, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: successAssertionAttrTypes()}, []SuccessAssertionModel{
+		{
+			Type:     types.StringValue("response_time"),
+			Operator: types.StringNull(),
+			Value:    types.StringValue("1500"),
+			Property: types.StringNull(),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	icmpReq, diags := types.ObjectValueFrom(ctx, icmpRequestAttrTypes(), ICMPRequestModel{
+		Host:        types.StringValue("1.1.1.1"),
+		PacketCount: types.Int64Value(3),
+		PacketSize:  types.Int64Null(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	data := UptimeMonitorResourceModel{
+		Protocol:          types.StringValue("icmp"),
+		ICMPRequest:       icmpReq,
+		DNSRequest:        types.ObjectNull(dnsRequestAttrTypes()),
+		HeartbeatRequest:  types.ObjectNull(heartbeatRequestAttrTypes()),
+		TCPRequest:        types.ObjectNull(tcpRequestAttrTypes()),
+		HTTPRequest:       types.ObjectNull(httpRequestAttrTypes()),
+		Regions:           stringSetValue(t, []string{"na-usa-iad"}),
+		SuccessAssertions: assertions,
+	}
+
+	monitor, diags := r.terraformToAPIModel(ctx, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	sent := monitor.SuccessAssertions[0]
+	if sent.Operator == nil || *sent.Operator != "less_than" {
+		t.Fatalf("sent response_time operator = %v, want less_than", sent.Operator)
+	}
+	if sent.Property != nil {
+		t.Fatalf("sent response_time property = %q, want nil", *sent.Property)
+	}
+
+	// The API returns an empty property, which must stay null in state
+	monitor.SuccessAssertions[0].Property = stringPtr("")
+	diags = r.apiToTerraformModel(ctx, monitor, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !data.SuccessAssertions.Equal(assertions) {
+		t.Errorf("success_assertions = %v, want %v", data.SuccessAssertions, assertions)
+	}
+}
+
+func TestUptimeMonitorAssertionsOrder(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{}
+
+	configured := []SuccessAssertionModel{
+		{
+			Type:     types.StringValue("status_code"),
+			Operator: types.StringNull(),
+			Value:    types.StringValue("2xx"),
+			Property: types.StringNull(),
+		},
+		{
+			Type:     types.StringValue("response_header"),
+			Operator: types.StringValue("equals"),
+			Value:    types.StringValue("application/json"),
+			Property: types.StringValue("Content-Type"),
+		},
+		{
+			Type:     types.StringValue("response_body"),
+			Operator: types.StringValue("contains"),
+			Value:    types.StringValue("ok"),
+			Property: types.StringNull(),
+		},
+	}
+	assertions, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: successAssertionAttrTypes()}, configured)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// The API returns the assertions in another order, with the default
+	// status_code operator filled in
+	monitor := &client.Monitor{
+		Name:     "test",
+		Protocol: "tcp",
+		Regions:  []string{"na-usa-iad"},
+		SuccessAssertions: []client.SuccessAssertion{
+			{Type: "response_body", Operator: stringPtr("contains"), Value: stringPtr("ok")},
+			{Type: "status_code", Operator: stringPtr("in"), Value: stringPtr("2xx")},
+			{Type: "response_header", Operator: stringPtr("equals"), Value: stringPtr("application/json"), Property: stringPtr("Content-Type")},
+		},
+	}
+
+	data := UptimeMonitorResourceModel{
+		Regions:           stringSetValue(t, []string{"na-usa-iad"}),
+		SuccessAssertions: assertions,
+	}
+
+	if diags := r.apiToTerraformModel(ctx, monitor, &data); diags.HasError() {
+		t.Fatalf("apiToTerraformModel() unexpected diagnostics: %v", diags)
+	}
+	if !data.SuccessAssertions.Equal(assertions) {
+		t.Errorf("success_assertions = %v, want %v", data.SuccessAssertions, assertions)
+	}
+}
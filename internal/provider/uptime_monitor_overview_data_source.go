@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UptimeMonitorOverviewDataSource{}
+
+func NewUptimeMonitorOverviewDataSource() datasource.DataSource {
+	return &UptimeMonitorOverviewDataSource{}
+}
+
+// UptimeMonitorOverviewDataSource defines the data source implementation.
+type UptimeMonitorOverviewDataSource struct {
+	client *client.Client
+}
+
+// UptimeMonitorOverviewDataSourceModel describes the data source data model.
+type UptimeMonitorOverviewDataSourceModel struct {
+	ID                  types.String  `tfsdk:"id"`
+	Name                types.String  `tfsdk:"name"`
+	Protocol            types.String  `tfsdk:"protocol"`
+	Paused              types.Bool    `tfsdk:"paused"`
+	UptimePercentage    types.Float64 `tfsdk:"uptime_percentage"`
+	AverageResponseTime types.Float64 `tfsdk:"average_response_time"`
+	Incidents           types.List    `tfsdk:"incidents"`
+	LastCheckAssertions types.List    `tfsdk:"last_check_assertion_results"`
+}
+
+func (d *UptimeMonitorOverviewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_uptime_monitor_overview"
+}
+
+func (d *UptimeMonitorOverviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a Phare uptime monitor together with its statistics and incidents.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the monitor",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the monitor",
+				Computed:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Monitoring protocol",
+				Computed:            true,
+			},
+			"paused": schema.BoolAttribute{
+				MarkdownDescription: "Whether the monitor is paused",
+				Computed:            true,
+			},
+			"uptime_percentage": schema.Float64Attribute{
+				MarkdownDescription: "Uptime percentage of the monitor",
+				Computed:            true,
+			},
+			"average_response_time": schema.Float64Attribute{
+				MarkdownDescription: "Average response time of the monitor in milliseconds",
+				Computed:            true,
+			},
+			"incidents": schema.ListNestedAttribute{
+				MarkdownDescription: "Incidents of the monitor",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the incident",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the incident",
+							Computed:            true,
+						},
+						"impact": schema.StringAttribute{
+							MarkdownDescription: "The impact level of the incident",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Current status of the incident (ongoing or resolved)",
+							Computed:            true,
+						},
+						"incident_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the incident occurred",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"last_check_assertion_results": schema.ListNestedAttribute{
+				MarkdownDescription: "Evaluation of the success assertions during the latest check of the monitor, " +
+					"useful to understand why checks fail. Empty when the monitor hasn't been checked yet",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the assertion",
+							Computed:            true,
+						},
+						"passed": schema.BoolAttribute{
+							MarkdownDescription: "Whether the assertion passed",
+							Computed:            true,
+						},
+						"actual": schema.StringAttribute{
+							MarkdownDescription: "Value observed by the check for the assertion",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UptimeMonitorOverviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UptimeMonitorOverviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UptimeMonitorOverviewDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading uptime monitor overview", map[string]any{"id": data.ID.ValueString()})
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid monitor ID", fmt.Sprintf("Failed to parse monitor ID: %s", err.Error()))
+		return
+	}
+
+	monitor, err := d.client.GetMonitorWithRelated(ctx, id, client.MonitorIncludeStats, client.MonitorIncludeIncidents, client.MonitorIncludeLastCheck)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read monitor", err.Error())
+		return
+	}
+
+	data.Name = types.StringValue(monitor.Name)
+	data.Protocol = types.StringValue(monitor.Protocol)
+	data.Paused = types.BoolValue(monitor.Paused != nil && *monitor.Paused)
+
+	data.UptimePercentage = types.Float64Null()
+	data.AverageResponseTime = types.Float64Null()
+	if monitor.Stats != nil {
+		data.UptimePercentage = types.Float64PointerValue(monitor.Stats.UptimePercentage)
+		data.AverageResponseTime = types.Float64PointerValue(monitor.Stats.AverageResponseTime)
+	}
+
+	incidentAttrTypes := map[string]attr.Type{
+		"id":          types.StringType,
+		"title":       types.StringType,
+		"impact":      types.StringType,
+		"status":      types.StringType,
+		"incident_at": types.StringType,
+	}
+
+	incidentElements := make([]attr.Value, len(monitor.Incidents))
+	for i, incident := range monitor.Incidents {
+		incidentID := types.StringNull()
+		if incident.ID != nil {
+			incidentID = types.StringValue(fmt.Sprintf("%d", *incident.ID))
+		}
+
+		incidentObj, diagObj := types.ObjectValue(
+			incidentAttrTypes,
+			map[string]attr.Value{
+				"id":          incidentID,
+				"title":       types.StringValue(incident.Title),
+				"impact":      types.StringValue(incident.Impact),
+				"status":      types.StringValue(incident.Status),
+				"incident_at": types.StringValue(incident.IncidentAt),
+			},
+		)
+		resp.Diagnostics.Append(diagObj...)
+		incidentElements[i] = incidentObj
+	}
+
+	incidentList, diagList := types.ListValue(types.ObjectType{AttrTypes: incidentAttrTypes}, incidentElements)
+	resp.Diagnostics.Append(diagList...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Incidents = incidentList
+
+	assertionResultAttrTypes := map[string]attr.Type{
+		"type":   types.StringType,
+		"passed": types.BoolType,
+		"actual": types.StringType,
+	}
+
+	// No check has run yet on new monitors, store an empty list
+	var assertionResults []client.CheckAssertionResult
+	if monitor.LastCheck != nil {
+		assertionResults = monitor.LastCheck.AssertionResults
+	}
+
+	assertionResultElements := make([]attr.Value, len(assertionResults))
+	for i, result := range assertionResults {
+		resultObj, diagObj := types.ObjectValue(
+			assertionResultAttrTypes,
+			map[string]attr.Value{
+				"type":   types.StringValue(result.Type),
+				"passed": types.BoolValue(result.Passed),
+				"actual": types.StringPointerValue(result.Actual),
+			},
+		)
+		resp.Diagnostics.Append(diagObj...)
+		assertionResultElements[i] = resultObj
+	}
+
+	assertionResultList, diagList := types.ListValue(types.ObjectType{AttrTypes: assertionResultAttrTypes}, assertionResultElements)
+	resp.Diagnostics.Append(diagList...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.LastCheckAssertions = assertionResultList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUptimeMonitorOverviewDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUptimeMonitorOverviewDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.phare_uptime_monitor_overview.test", "name",
+						"phare_uptime_monitor.test", "name",
+					),
+					resource.TestCheckResourceAttr("data.phare_uptime_monitor_overview.test", "protocol", "http"),
+					resource.TestCheckResourceAttrSet("data.phare_uptime_monitor_overview.test", "incidents.#"),
+					resource.TestCheckResourceAttrSet("data.phare_uptime_monitor_overview.test", "last_check_assertion_results.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUptimeMonitorOverviewDataSourceConfig() string {
+	return `
+resource "phare_uptime_monitor" "test" {
+  name     = "Test Overview Monitor"
+  protocol = "http"
+
+  http_request = {
+    method = "GET"
+    url    = "https://immich.app"
+  }
+
+  interval                = 60
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+}
+
+data "phare_uptime_monitor_overview" "test" {
+  id = phare_uptime_monitor.test.id
+}
+`
+}
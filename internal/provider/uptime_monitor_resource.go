@@ -7,25 +7,68 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
-	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/phare/terraform-provider-phare/internal/client"
+	"github.com/phare/terraform-provider-phare/internal/planmodifiers"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &UptimeMonitorResource{}
 var _ resource.ResourceWithImportState = &UptimeMonitorResource{}
+var _ resource.ResourceWithValidateConfig = &UptimeMonitorResource{}
+var _ resource.ResourceWithConfigValidators = &UptimeMonitorResource{}
+var _ resource.ResourceWithModifyPlan = &UptimeMonitorResource{}
+
+const (
+	// defaultDeleteTimeout is the delete timeout used when timeouts.delete is
+	// unset, it bounds how long verify_delete waits for a deleted monitor to
+	// stop being readable
+	defaultDeleteTimeout = 5 * time.Minute
+
+	// deleteVerifyInterval is the delay between verify_delete reads
+	deleteVerifyInterval = 2 * time.Second
+)
+
+// monitorProtocols lists the protocols a monitor can check.
+var monitorProtocols = []string{"http", "tcp", "icmp", "dns", "heartbeat"}
+
+// dnsRecordTypes lists the record types a DNS monitor can resolve.
+var dnsRecordTypes = []string{"A", "AAAA", "CNAME", "MX", "TXT"}
+
+// monitorRegions lists the regions monitoring checks can be performed from.
+var monitorRegions = []string{
+	"as-jpn-hnd", "as-sgp-sin", "as-tha-bkk",
+	"eu-deu-fra", "eu-gbr-lhr", "eu-swe-arn", "ng-nld-ams",
+	"na-mex-mex", "na-usa-iad", "na-usa-sea",
+	"oc-aus-syd", "sa-bra-gru",
+}
+
+// tlsVersions lists the TLS protocol versions accepted by tls.min_version.
+var tlsVersions = []string{"1.0", "1.1", "1.2", "1.3"}
+
+// httpVersionAuto lets the monitor negotiate the HTTP protocol version, it is
+// the API default and never sent.
+const httpVersionAuto = "auto"
 
 func NewUptimeMonitorResource() resource.Resource {
 	return &UptimeMonitorResource{}
@@ -33,7 +76,10 @@ func NewUptimeMonitorResource() resource.Resource {
 
 // UptimeMonitorResource defines the resource implementation.
 type UptimeMonitorResource struct {
-	client *client.Client
+	client       client.MonitorAPI
+	maxRegions   int
+	verifyDelete bool
+	logChanges   bool
 }
 
 // UptimeMonitorResourceModel describes the resource data model.
@@ -43,25 +89,46 @@ type UptimeMonitorResourceModel struct {
 	Protocol              types.String `tfsdk:"protocol"`
 	HTTPRequest           types.Object `tfsdk:"http_request"`
 	TCPRequest            types.Object `tfsdk:"tcp_request"`
+	ICMPRequest           types.Object `tfsdk:"icmp_request"`
+	DNSRequest            types.Object `tfsdk:"dns_request"`
+	HeartbeatRequest      types.Object `tfsdk:"heartbeat_request"`
 	Interval              types.Int64  `tfsdk:"interval"`
 	Timeout               types.Int64  `tfsdk:"timeout"`
 	IncidentConfirmations types.Int64  `tfsdk:"incident_confirmations"`
 	RecoveryConfirmations types.Int64  `tfsdk:"recovery_confirmations"`
-	Regions               types.List   `tfsdk:"regions"`
-	SuccessAssertions     types.List   `tfsdk:"success_assertions"`
+	Regions               types.Set    `tfsdk:"regions"`
+	SuccessAssertions     types.Set    `tfsdk:"success_assertions"`
 	Paused                types.Bool   `tfsdk:"paused"`
+	Status                types.String `tfsdk:"status"`
+	ResolvedIPFamily      types.String `tfsdk:"resolved_ip_family"`
+	PingURL               types.String `tfsdk:"ping_url"`
+	EstimatedChecks       types.Int64  `tfsdk:"estimated_checks_per_month"`
 	CreatedAt             types.String `tfsdk:"created_at"`
 	UpdatedAt             types.String `tfsdk:"updated_at"`
+	Tags                  types.Map    `tfsdk:"tags"`
+	BaseURL               types.String `tfsdk:"base_url"`
+}
+
+// uptimeMonitorResourceData adds the resource-only timeouts to the model
+// shared with the uptime monitor data sources.
+type uptimeMonitorResourceData struct {
+	UptimeMonitorResourceModel
+	Timeouts types.Object `tfsdk:"timeouts"`
 }
 
 type HTTPRequestModel struct {
 	Method          types.String `tfsdk:"method"`
 	URL             types.String `tfsdk:"url"`
 	TLSSkipVerify   types.Bool   `tfsdk:"tls_skip_verify"`
+	TLS             types.Object `tfsdk:"tls"`
 	Body            types.String `tfsdk:"body"`
+	BodyFile        types.String `tfsdk:"body_file"`
 	FollowRedirects types.Bool   `tfsdk:"follow_redirects"`
+	HTTPVersion     types.String `tfsdk:"http_version"`
 	UserAgentSecret types.String `tfsdk:"user_agent_secret"`
-	Headers         types.List   `tfsdk:"headers"`
+	QueryParams     types.Map    `tfsdk:"query_params"`
+	Cookies         types.Map    `tfsdk:"cookies"`
+	Headers         types.Set    `tfsdk:"headers"`
 }
 
 type TCPRequestModel struct {
@@ -69,6 +136,30 @@ type TCPRequestModel struct {
 	Port          types.String `tfsdk:"port"`
 	Connection    types.String `tfsdk:"connection"`
 	TLSSkipVerify types.Bool   `tfsdk:"tls_skip_verify"`
+	TLS           types.Object `tfsdk:"tls"`
+}
+
+// TLSModel describes the TLS settings of an http_request or tcp_request
+type TLSModel struct {
+	MinVersion types.String `tfsdk:"min_version"`
+	ServerName types.String `tfsdk:"server_name"`
+}
+
+type ICMPRequestModel struct {
+	Host        types.String `tfsdk:"host"`
+	PacketCount types.Int64  `tfsdk:"packet_count"`
+	PacketSize  types.Int64  `tfsdk:"packet_size"`
+}
+
+type HeartbeatRequestModel struct {
+	Period types.Int64 `tfsdk:"period"`
+	Grace  types.Int64 `tfsdk:"grace"`
+}
+
+type DNSRequestModel struct {
+	Host       types.String `tfsdk:"host"`
+	RecordType types.String `tfsdk:"record_type"`
+	Resolver   types.String `tfsdk:"resolver"`
 }
 
 type RequestHeaderModel struct {
@@ -89,7 +180,8 @@ func (r *UptimeMonitorResource) Metadata(ctx context.Context, req resource.Metad
 
 func (r *UptimeMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Phare uptime monitor for HTTP or TCP endpoints.",
+		MarkdownDescription: "Manages a Phare uptime monitor for HTTP or TCP endpoints, ICMP host reachability, DNS record resolution " +
+			"or heartbeats sent by cron jobs and workers.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -100,35 +192,48 @@ func (r *UptimeMonitorResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the monitor (2-30 characters)",
+				MarkdownDescription: "Name of the monitor (2-30 characters). Creating a monitor with the name of an existing monitor shows a warning",
 				Required:            true,
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(2, 30),
 				},
 			},
 			"protocol": schema.StringAttribute{
-				MarkdownDescription: "Monitoring protocol: `http` or `tcp`",
-				Required:            true,
+				MarkdownDescription: "Monitoring protocol: `http`, `tcp`, `icmp`, `dns` or `heartbeat`. The request attribute of the protocol, " +
+					"such as `http_request` for `http`, must be set and the request attributes of the other protocols must not. " +
+					"This is checked at plan time against the whole configuration. Changing this forces a new resource to be created",
+				Required: true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("http", "tcp"),
+					stringvalidator.OneOf(monitorProtocols...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"http_request": schema.SingleNestedAttribute{
 				MarkdownDescription: "HTTP request configuration (required when protocol is `http`)",
 				Optional:            true,
+				Validators: []validator.Object{
+					queryParamsValidator{},
+					cookiesValidator{},
+				},
 				Attributes: map[string]schema.Attribute{
 					"method": schema.StringAttribute{
 						MarkdownDescription: "HTTP method",
 						Required:            true,
 						Validators: []validator.String{
-							stringvalidator.OneOf("HEAD", "GET", "POST", "PUT", "PATCH", "OPTIONS"),
+							stringvalidator.OneOf("HEAD", "GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"),
 						},
 					},
 					"url": schema.StringAttribute{
-						MarkdownDescription: "URL to monitor (max 255 characters)",
+						MarkdownDescription: "Absolute `http` or `https` URL to monitor (max 255 characters)",
 						Required:            true,
 						Validators: []validator.String{
 							stringvalidator.LengthAtMost(255),
+							IsAbsoluteHTTPURL(),
+						},
+						PlanModifiers: []planmodifier.String{
+							planmodifiers.NormalizedString(planmodifiers.TrimSpace),
 						},
 					},
 					"tls_skip_verify": schema.BoolAttribute{
@@ -137,29 +242,65 @@ func (r *UptimeMonitorResource) Schema(ctx context.Context, req resource.SchemaR
 						Computed:            true,
 						Default:             booldefault.StaticBool(false),
 					},
+					"tls": tlsAttribute("TLS settings of `https` URLs", "the URL host"),
 					"body": schema.StringAttribute{
-						MarkdownDescription: "Request body for POST, PUT, PATCH (max 500 characters)",
+						MarkdownDescription: "Request body for POST, PUT, PATCH (max 500 characters). Set to the contents of `body_file` when it is used",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(maxBodyLength),
+						},
+						PlanModifiers: []planmodifier.String{
+							bodyFromFilePlanModifier{},
+						},
+					},
+					"body_file": schema.StringAttribute{
+						MarkdownDescription: "Path to a file whose contents are sent as the request body, read on every plan and apply. Conflicts with `body`",
 						Optional:            true,
 						Validators: []validator.String{
-							stringvalidator.LengthAtMost(500),
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("body")),
+							bodyFileValidator{},
 						},
 					},
 					"follow_redirects": schema.BoolAttribute{
-						MarkdownDescription: "Follow HTTP redirects",
+						MarkdownDescription: "Follow HTTP redirects. When enabled, success assertions apply to the final response; disable it to assert on a `3xx` redirect response",
 						Optional:            true,
 						Computed:            true,
 						Default:             booldefault.StaticBool(true),
 					},
+					"http_version": schema.StringAttribute{
+						MarkdownDescription: "HTTP protocol version used by checks: `1.1`, `2`, or `auto` to negotiate it. Defaults to `auto`",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(httpVersionAuto),
+						Validators: []validator.String{
+							stringvalidator.OneOf("1.1", "2", httpVersionAuto),
+						},
+					},
 					"user_agent_secret": schema.StringAttribute{
 						MarkdownDescription: "Secret value for User-Agent header authentication",
 						Optional:            true,
 						Sensitive:           true,
 					},
-					"headers": schema.ListNestedAttribute{
-						MarkdownDescription: "Additional HTTP headers (max 10)",
+					"query_params": schema.MapAttribute{
+						MarkdownDescription: "Query parameters encoded and appended to `url`. Parameters must not also be present in `url`",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"cookies": schema.MapAttribute{
+						MarkdownDescription: "Cookies sent with the request, keyed by cookie name. Sent as a `Cookie` header, which counts towards the `headers` limit",
+						Optional:            true,
+						Sensitive:           true,
+						ElementType:         types.StringType,
+						Validators: []validator.Map{
+							mapvalidator.KeysAre(stringvalidator.RegexMatches(headerNameRegexp, "must be a valid cookie name")),
+						},
+					},
+					"headers": schema.SetNestedAttribute{
+						MarkdownDescription: "Additional HTTP headers (max 10). The order of the headers doesn't matter",
 						Optional:            true,
-						Validators: []validator.List{
-							listvalidator.SizeAtMost(10),
+						Validators: []validator.Set{
+							setvalidator.SizeAtMost(10),
 						},
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
@@ -185,8 +326,12 @@ func (r *UptimeMonitorResource) Schema(ctx context.Context, req resource.SchemaR
 						Required:            true,
 					},
 					"port": schema.StringAttribute{
-						MarkdownDescription: "TCP port",
+						MarkdownDescription: "TCP port (1-65535)",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(portRegexp, "must be a port number"),
+							portValidator{},
+						},
 					},
 					"connection": schema.StringAttribute{
 						MarkdownDescription: "Connection type: `plain` or `tls`",
@@ -201,6 +346,85 @@ func (r *UptimeMonitorResource) Schema(ctx context.Context, req resource.SchemaR
 						Computed:            true,
 						Default:             booldefault.StaticBool(false),
 					},
+					"tls": tlsAttribute("TLS settings when connection is `tls`", "the host"),
+				},
+			},
+			"icmp_request": schema.SingleNestedAttribute{
+				MarkdownDescription: "ICMP (ping) request configuration (required when protocol is `icmp`)",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "Hostname or IP address to ping",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"packet_count": schema.Int64Attribute{
+						MarkdownDescription: "Number of packets sent by each check (1-10). Defaults to `3`",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(3),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 10),
+						},
+					},
+					"packet_size": schema.Int64Attribute{
+						MarkdownDescription: "Payload size of each packet in bytes (1-1472), defaults to the Phare default",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 1472),
+						},
+					},
+				},
+			},
+			"dns_request": schema.SingleNestedAttribute{
+				MarkdownDescription: "DNS request configuration (required when protocol is `dns`). " +
+					"Use `record_value` success assertions to check the resolved records",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "Domain name to resolve",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"record_type": schema.StringAttribute{
+						MarkdownDescription: "DNS record type to resolve: `A`, `AAAA`, `CNAME`, `MX` or `TXT`",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(dnsRecordTypes...),
+						},
+					},
+					"resolver": schema.StringAttribute{
+						MarkdownDescription: "IP address of the DNS resolver to query, defaults to the Phare resolver",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+			"heartbeat_request": schema.SingleNestedAttribute{
+				MarkdownDescription: "Heartbeat configuration (required when protocol is `heartbeat`). " +
+					"Heartbeat monitors don't probe an endpoint, they expect a request to `ping_url` every `period` seconds",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"period": schema.Int64Attribute{
+						MarkdownDescription: "Expected number of seconds between two pings",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"grace": schema.Int64Attribute{
+						MarkdownDescription: "Number of seconds a ping can be late before the monitor fails",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
 				},
 			},
 			"interval": schema.Int64Attribute{
@@ -231,51 +455,93 @@ func (r *UptimeMonitorResource) Schema(ctx context.Context, req resource.SchemaR
 					int64validator.Between(1, 5),
 				},
 			},
-			"regions": schema.ListAttribute{
-				MarkdownDescription: "List of regions where monitoring checks are performed (1-6 regions)",
+			"regions": schema.SetAttribute{
+				MarkdownDescription: "Set of regions where monitoring checks are performed (1-6 regions)",
 				Required:            true,
 				ElementType:         types.StringType,
-				Validators: []validator.List{
-					listvalidator.SizeBetween(1, 6),
-					listvalidator.ValueStringsAre(stringvalidator.OneOf(
-						"as-jpn-hnd", "as-sgp-sin", "as-tha-bkk",
-						"eu-deu-fra", "eu-gbr-lhr", "eu-swe-arn", "ng-nld-ams",
-						"na-mex-mex", "na-usa-iad", "na-usa-sea",
-						"oc-aus-syd", "sa-bra-gru",
-					)),
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 6),
+					setvalidator.ValueStringsAre(stringvalidator.OneOfCaseInsensitive(monitorRegions...)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					planmodifiers.NormalizedStringSet(planmodifiers.Lowercase),
 				},
 			},
-			"success_assertions": schema.ListNestedAttribute{
-				MarkdownDescription: "List of assertions that must be true for check success",
-				Optional:            true,
+			"success_assertions": schema.SetNestedAttribute{
+				MarkdownDescription: "Set of assertions that must be true for check success. " +
+					"All assertions must pass, the Phare API has no grouped (OR) assertions. " +
+					"When unset, the default `status_code` in `2xx` assertion added by Phare is kept in state without a diff, " +
+					"and assertions removed from the configuration are cleared",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Set{
+					successAssertionsDefaultPlanModifier{},
+				},
 				NestedObject: schema.NestedAttributeObject{
+					Validators: []validator.Object{
+						successAssertionPropertyValidator{},
+						successAssertionNumericValidator{},
+						successAssertionProtocolValidator{},
+					},
 					Attributes: map[string]schema.Attribute{
 						"type": schema.StringAttribute{
-							MarkdownDescription: "Assertion type: `status_code`, `response_header`, or `response_body`",
-							Required:            true,
+							MarkdownDescription: "Assertion type: `status_code`, `response_header`, `response_body`, `response_time`, " +
+								"`certificate_expiry_days` for the TLS certificate of `http` monitors, or `record_value` for the records resolved by `dns` monitors",
+							Required: true,
 							Validators: []validator.String{
-								stringvalidator.OneOf("status_code", "response_header", "response_body"),
+								stringvalidator.OneOf("status_code", "response_header", "response_body", "response_time", "certificate_expiry_days", "record_value"),
 							},
 						},
 						"operator": schema.StringAttribute{
-							MarkdownDescription: "Comparison operator",
-							Optional:            true,
+							MarkdownDescription: "Comparison operator. Defaults to `in` for `status_code`, `equals` for `response_header`, `contains` for `response_body` and `record_value`, and `less_than` for `response_time` and `certificate_expiry_days` assertions. " +
+								"`response_time` assertions only support `less_than` and `greater_than`, `certificate_expiry_days` assertions only `less_than`",
+							Optional: true,
 						},
 						"value": schema.StringAttribute{
-							MarkdownDescription: "Expected value",
+							MarkdownDescription: "Expected value, in milliseconds for `response_time` and in days for `certificate_expiry_days` assertions",
 							Optional:            true,
 						},
 						"property": schema.StringAttribute{
-							MarkdownDescription: "Property name (for response_header type)",
+							MarkdownDescription: "Header name for `response_header` assertions, must be unset for other types",
 							Optional:            true,
 						},
 					},
 				},
 			},
 			"paused": schema.BoolAttribute{
-				MarkdownDescription: "Whether the monitor is paused",
+				MarkdownDescription: "Whether the monitor is paused. Monitors created paused don't run any check until resumed",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Labels to group and filter monitors in Terraform. " +
+					"The Phare API has no monitor tags, so tags are only stored in the Terraform state and aren't restored on import",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"estimated_checks_per_month": schema.Int64Attribute{
+				MarkdownDescription: "Estimated number of checks performed per month, computed as `30 * 24 * 3600 / interval * length(regions)`. Useful for cost planning",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Current status of the monitor reported by Phare: `up`, `down`, `paused` or `pending` until the first check completes",
+				Computed:            true,
+			},
+			"resolved_ip_family": schema.StringAttribute{
+				MarkdownDescription: "IP address family (`ipv4` or `ipv6`) used by the most recent check, null until the monitor has been checked",
+				Computed:            true,
+			},
+			"ping_url": schema.StringAttribute{
+				MarkdownDescription: "URL cron jobs and workers request to check in, null unless protocol is `heartbeat`. " +
+					"The URL contains the secret ping token of the monitor",
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Timestamp when the monitor was created",
@@ -288,10 +554,179 @@ func (r *UptimeMonitorResource) Schema(ctx context.Context, req resource.SchemaR
 				MarkdownDescription: "Timestamp when the monitor was last updated",
 				Computed:            true,
 			},
+			"timeouts": deleteTimeoutsAttribute(defaultDeleteTimeout),
+			"base_url": baseURLAttribute(),
 		},
 	}
 }
 
+// tlsAttribute returns the tls attribute of a request, whose server name
+// defaults to serverNameDefault
+func tlsAttribute(description, serverNameDefault string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"min_version": schema.StringAttribute{
+				MarkdownDescription: "Minimum accepted TLS version: `1.0`, `1.1`, `1.2`, or `1.3`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(tlsVersions...),
+				},
+			},
+			"server_name": schema.StringAttribute{
+				MarkdownDescription: "Server name (SNI) sent during the TLS handshake, defaults to " + serverNameDefault,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *UptimeMonitorResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		protocolRequestValidator{},
+	}
+}
+
+func (r *UptimeMonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data uptimeMonitorResourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.HTTPRequest.IsNull() || data.HTTPRequest.IsUnknown() || data.SuccessAssertions.IsNull() || data.SuccessAssertions.IsUnknown() {
+		return
+	}
+
+	var httpReq HTTPRequestModel
+	resp.Diagnostics.Append(data.HTTPRequest.As(ctx, &httpReq, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+	if resp.Diagnostics.HasError() || httpReq.FollowRedirects.IsUnknown() {
+		return
+	}
+
+	// follow_redirects defaults to true
+	if !httpReq.FollowRedirects.IsNull() && !httpReq.FollowRedirects.ValueBool() {
+		return
+	}
+
+	elements := data.SuccessAssertions.Elements()
+	var assertions []SuccessAssertionModel
+	resp.Diagnostics.Append(data.SuccessAssertions.ElementsAs(ctx, &assertions, false)...)
+
+	// Redirect responses are never observed while following redirects, so an
+	// assertion expecting one can't succeed
+	for i, a := range assertions {
+		if a.Type.ValueString() != "status_code" || a.Value.IsNull() || a.Value.IsUnknown() {
+			continue
+		}
+		if !isRedirectStatus(a.Value.ValueString()) {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("success_assertions").AtSetValue(elements[i]).AtName("value"),
+			"Redirect Status Assertion Never Matches",
+			fmt.Sprintf("The status_code assertion expects %q, but follow_redirects is enabled so assertions apply to the final response after redirects. "+
+				"Set http_request.follow_redirects to false to assert that a redirect occurred.", a.Value.ValueString()),
+		)
+	}
+}
+
+// validateRegionCount checks the configured regions against the number of
+// regions allowed by the account's plan
+func (r *UptimeMonitorResource) validateRegionCount(data *UptimeMonitorResourceModel, diags *diag.Diagnostics) {
+	if r.maxRegions <= 0 || data.Regions.IsNull() || data.Regions.IsUnknown() {
+		return
+	}
+
+	if count := len(data.Regions.Elements()); count > r.maxRegions {
+		diags.AddAttributeError(
+			path.Root("regions"),
+			"Too Many Regions",
+			fmt.Sprintf("Your plan allows up to %d regions per monitor, but %d are configured. "+
+				"Remove regions or raise max_regions in the provider configuration after upgrading your plan.", r.maxRegions, count),
+		)
+	}
+}
+
+// isRedirectStatus reports whether a status_code assertion value only matches
+// 3xx redirect responses
+func isRedirectStatus(value string) bool {
+	value = strings.TrimSpace(strings.ToLower(value))
+	if value == "3xx" {
+		return true
+	}
+
+	code, err := strconv.Atoi(value)
+	return err == nil && code >= 300 && code < 400
+}
+
+const (
+	// duplicateNameCheckLimit bounds the number of monitors searched for a
+	// duplicate name, so that planning large accounts stays fast
+	duplicateNameCheckLimit = 500
+
+	// duplicateNameCheckTimeout bounds the duplicate name check, which must
+	// not hold up the plan when the API is slow or retrying
+	duplicateNameCheckTimeout = 10 * time.Second
+)
+
+// monitorFinder is implemented by the clients able to find monitors by name,
+// which are searched to warn about duplicate monitor names
+type monitorFinder interface {
+	FindMonitorByName(ctx context.Context, name string, limit int) (*client.Monitor, error)
+}
+
+func (r *UptimeMonitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only creates are checked, existing monitors already exist under their
+	// name and warning on every update would be noise
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var name, baseURL types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("base_url"), &baseURL)...)
+	if resp.Diagnostics.HasError() || name.IsUnknown() {
+		return
+	}
+
+	finder, ok := scopedClient(r.client, baseURL).(monitorFinder)
+	if !ok {
+		return
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, duplicateNameCheckTimeout)
+	defer cancel()
+
+	// The check is advisory, failing to list monitors must not fail the plan
+	monitor, err := finder.FindMonitorByName(checkCtx, name.ValueString(), duplicateNameCheckLimit)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to check for duplicate monitor names", map[string]any{"error": err.Error()})
+		return
+	}
+
+	warnOnDuplicateMonitorName(name.ValueString(), monitor, &resp.Diagnostics)
+}
+
+// warnOnDuplicateMonitorName adds a warning when an existing monitor already
+// uses name. The API accepts duplicate names, but they are ambiguous in the
+// dashboards.
+func warnOnDuplicateMonitorName(name string, existing *client.Monitor, diags *diag.Diagnostics) {
+	if existing == nil || existing.ID == nil {
+		return
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("name"),
+		"Duplicate monitor name",
+		fmt.Sprintf("Monitor %d is already named %q, both monitors will display ambiguously in the dashboards. "+
+			"Consider choosing another name.", *existing.ID, name),
+	)
+}
+
 func (r *UptimeMonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -307,18 +742,26 @@ func (r *UptimeMonitorResource) Configure(ctx context.Context, req resource.Conf
 	}
 
 	r.client = client
+	r.maxRegions = client.MaxRegions()
+	r.verifyDelete = client.VerifyDelete()
+	r.logChanges = client.LogChanges()
 }
 
 func (r *UptimeMonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data UptimeMonitorResourceModel
+	var data uptimeMonitorResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	r.validateRegionCount(&data.UptimeMonitorResourceModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert Terraform model to API model
-	monitor, diags := r.terraformToAPIModel(ctx, &data)
+	monitor, diags := r.terraformToAPIModel(ctx, &data.UptimeMonitorResourceModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -326,8 +769,15 @@ func (r *UptimeMonitorResource) Create(ctx context.Context, req resource.CreateR
 
 	tflog.Debug(ctx, "Creating uptime monitor", map[string]any{"name": data.Name.ValueString()})
 
+	// Create paused monitors in the paused state so that no check runs
+	// before they are enabled
+	if !data.Paused.IsNull() && !data.Paused.IsUnknown() && data.Paused.ValueBool() {
+		monitor.Paused = boolPtr(true)
+	}
+
 	// Create monitor via API
-	created, err := r.client.CreateMonitor(ctx, monitor)
+	api := scopedClient(r.client, data.BaseURL)
+	created, err := api.CreateMonitor(ctx, monitor)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create monitor", err.Error())
 		return
@@ -339,24 +789,29 @@ func (r *UptimeMonitorResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	// Handle pause state if needed
-	if !data.Paused.IsNull() && data.Paused.ValueBool() {
-		if err := r.client.PauseMonitor(ctx, *created.ID); err != nil {
+	// Fall back to the pause endpoint if the create did not apply the pause state
+	if monitor.Paused != nil && (created.Paused == nil || !*created.Paused) {
+		tflog.Debug(ctx, "Create did not apply pause state, falling back to pause", map[string]any{"id": *created.ID})
+
+		if err := api.PauseMonitor(ctx, *created.ID); err != nil {
 			resp.Diagnostics.AddError("Failed to pause monitor", err.Error())
 			return
 		}
 	}
 
 	// Read back the monitor to get all fields (created_at, updated_at, etc.)
-	fullMonitor, err := r.client.GetMonitor(ctx, *created.ID)
+	fullMonitor, err := api.GetMonitor(ctx, *created.ID)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read created monitor", err.Error())
 		return
 	}
 
-	// Convert API response back to Terraform model
-	diags = r.apiToTerraformModel(ctx, fullMonitor, &data)
+	// Convert API response back to Terraform model, keeping the planned url
+	// when the API only trimmed it
+	plannedRequest := data.HTTPRequest
+	diags = r.apiToTerraformModel(ctx, fullMonitor, &data.UptimeMonitorResourceModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(keepPlannedRequestURL(ctx, plannedRequest, &data.UptimeMonitorResourceModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -365,7 +820,7 @@ func (r *UptimeMonitorResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *UptimeMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data UptimeMonitorResourceModel
+	var data uptimeMonitorResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -380,13 +835,20 @@ func (r *UptimeMonitorResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	monitor, err := r.client.GetMonitor(ctx, id)
+	api := scopedClient(r.client, data.BaseURL)
+	monitor, err := api.GetMonitor(ctx, id)
+	if client.IsNotFound(err) {
+		// Deleted outside Terraform, remove it so that it's planned for creation
+		tflog.Warn(ctx, "Uptime monitor not found, removing from state", map[string]any{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read monitor", err.Error())
 		return
 	}
 
-	diags := r.apiToTerraformModel(ctx, monitor, &data)
+	diags := r.apiToTerraformModel(ctx, monitor, &data.UptimeMonitorResourceModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -396,8 +858,8 @@ func (r *UptimeMonitorResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *UptimeMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data UptimeMonitorResourceModel
-	var state UptimeMonitorResourceModel
+	var data uptimeMonitorResourceData
+	var state uptimeMonitorResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -405,14 +867,22 @@ func (r *UptimeMonitorResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	r.validateRegionCount(&data.UptimeMonitorResourceModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert Terraform model to API model
-	monitor, diags := r.terraformToAPIModel(ctx, &data)
+	monitor, diags := r.terraformToAPIModel(ctx, &data.UptimeMonitorResourceModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	tflog.Debug(ctx, "Updating uptime monitor", map[string]any{"id": data.ID.ValueString()})
+	if r.logChanges {
+		logChangedAttributes(ctx, "Updating uptime monitor attributes", data.ID.ValueString(), req.Plan, req.State)
+	}
 
 	id, err := strconv.Atoi(data.ID.ValueString())
 	if err != nil {
@@ -420,32 +890,45 @@ func (r *UptimeMonitorResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	updated, err := r.client.UpdateMonitor(ctx, id, monitor)
+	// Send the desired pause state along with the rest of the update
+	statePaused := !state.Paused.IsNull() && state.Paused.ValueBool()
+	if !data.Paused.IsNull() && !data.Paused.IsUnknown() {
+		monitor.Paused = boolPtr(data.Paused.ValueBool())
+	}
+
+	api := scopedClient(r.client, data.BaseURL)
+	updated, err := api.UpdateMonitor(ctx, id, monitor)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update monitor", err.Error())
 		return
 	}
 
-	// Handle pause/resume state changes
-	statePaused := !state.Paused.IsNull() && state.Paused.ValueBool()
-	planPaused := !data.Paused.IsNull() && data.Paused.ValueBool()
-
-	if planPaused && !statePaused {
-		if err := r.client.PauseMonitor(ctx, id); err != nil {
-			resp.Diagnostics.AddError("Failed to pause monitor", err.Error())
-			return
+	// Fall back to the pause/resume endpoints if the update did not apply
+	// the requested pause state change
+	if monitor.Paused != nil && *monitor.Paused != statePaused &&
+		(updated.Paused == nil || *updated.Paused != *monitor.Paused) {
+		tflog.Debug(ctx, "Update did not apply pause state, falling back to pause/resume", map[string]any{"id": id})
+
+		if *monitor.Paused {
+			if err := api.PauseMonitor(ctx, id); err != nil {
+				resp.Diagnostics.AddError("Failed to pause monitor", err.Error())
+				return
+			}
+		} else {
+			if err := api.ResumeMonitor(ctx, id); err != nil {
+				resp.Diagnostics.AddError("Failed to resume monitor", err.Error())
+				return
+			}
 		}
-		updated.Paused = &[]bool{true}[0]
-	} else if !planPaused && statePaused {
-		if err := r.client.ResumeMonitor(ctx, id); err != nil {
-			resp.Diagnostics.AddError("Failed to resume monitor", err.Error())
-			return
-		}
-		updated.Paused = &[]bool{false}[0]
+		updated.Paused = monitor.Paused
 	}
 
-	diags = r.apiToTerraformModel(ctx, updated, &data)
+	// Convert API response back to Terraform model, keeping the planned url
+	// when the API only trimmed it
+	plannedRequest := data.HTTPRequest
+	diags = r.apiToTerraformModel(ctx, updated, &data.UptimeMonitorResourceModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(keepPlannedRequestURL(ctx, plannedRequest, &data.UptimeMonitorResourceModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -454,7 +937,7 @@ func (r *UptimeMonitorResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *UptimeMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data UptimeMonitorResourceModel
+	var data uptimeMonitorResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -469,14 +952,51 @@ func (r *UptimeMonitorResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	if err := r.client.DeleteMonitor(ctx, id); err != nil {
+	api := scopedClient(r.client, data.BaseURL)
+	if err := api.DeleteMonitor(ctx, id); err != nil {
 		resp.Diagnostics.AddError("Failed to delete monitor", err.Error())
 		return
 	}
+
+	if r.verifyDelete {
+		timeout, diags := deleteTimeout(ctx, data.Timeouts, defaultDeleteTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := waitForMonitorDeleted(ctx, api, id, timeout, deleteVerifyInterval); err != nil {
+			resp.Diagnostics.AddError("Failed to verify monitor deletion", err.Error())
+			return
+		}
+	}
+}
+
+// waitForMonitorDeleted polls the monitor until the API reports it as not
+// found, or the timeout expires
+func waitForMonitorDeleted(ctx context.Context, api client.MonitorAPI, id int, timeout, interval time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		_, err := api.GetMonitor(ctx, id)
+		if client.IsNotFound(err) {
+			return nil
+		}
+		if err != nil && ctx.Err() == nil {
+			return fmt.Errorf("failed to read deleted monitor %d: %w", id, err)
+		}
+
+		tflog.Debug(ctx, "Deleted monitor still readable, waiting", map[string]any{"id": id})
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("monitor %d was still readable %s after it was deleted", id, timeout)
+		case <-time.After(interval):
+		}
+	}
 }
 
 func (r *UptimeMonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
-
-// Helper functions to convert between Terraform and API models will be added in next file
@@ -4,14 +4,22 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/phare/terraform-provider-phare/internal/client"
 )
 
 func TestAccUptimeMonitorResource_HTTP(t *testing.T) {
@@ -83,6 +91,485 @@ func TestAccUptimeMonitorResource_TCP(t *testing.T) {
 	})
 }
 
+func TestAccUptimeMonitorResource_ICMP(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUptimeMonitorResourceConfig_ICMP("1.1.1.1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("protocol"),
+						knownvalue.StringExact("icmp"),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("icmp_request").AtMapKey("packet_count"),
+						knownvalue.Int64Exact(3),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "phare_uptime_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccUptimeMonitorResource_DNS(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUptimeMonitorResourceConfig_DNS("A", "1.1.1.1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("protocol"),
+						knownvalue.StringExact("dns"),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("dns_request").AtMapKey("record_type"),
+						knownvalue.StringExact("A"),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "phare_uptime_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccUptimeMonitorResourceConfig_DNS("AAAA", "2606:4700:4700::1111"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("dns_request").AtMapKey("record_type"),
+						knownvalue.StringExact("AAAA"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccUptimeMonitorResource_Heartbeat(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUptimeMonitorResourceConfig_Heartbeat(3600),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("heartbeat_request").AtMapKey("period"),
+						knownvalue.Int64Exact(3600),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("ping_url"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "phare_uptime_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccUptimeMonitorResourceConfig_Heartbeat(86400),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("heartbeat_request").AtMapKey("period"),
+						knownvalue.Int64Exact(86400),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccUptimeMonitorResource_ProtocolChange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUptimeMonitorResourceConfig_HTTP("https://immich.app", 60),
+			},
+			// The request shapes differ, so the monitor is replaced
+			{
+				Config: testAccUptimeMonitorResourceConfig_TCP("8.8.8.8", "53"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("phare_uptime_monitor.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("protocol"),
+						knownvalue.StringExact("tcp"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccUptimeMonitorResource_TLS(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUptimeMonitorResourceConfig_TLS("1.2", "immich.app"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("http_request").AtMapKey("tls").AtMapKey("min_version"),
+						knownvalue.StringExact("1.2"),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("http_request").AtMapKey("tls").AtMapKey("server_name"),
+						knownvalue.StringExact("immich.app"),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "phare_uptime_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccUptimeMonitorResourceConfig_TLS("1.3", "immich.app"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("http_request").AtMapKey("tls").AtMapKey("min_version"),
+						knownvalue.StringExact("1.3"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccUptimeMonitorResource_UpdateWithPause(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUptimeMonitorResourceConfig_Paused(60, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("paused"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			// Update interval and pause in the same plan
+			{
+				Config: testAccUptimeMonitorResourceConfig_Paused(120, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("interval"),
+						knownvalue.Int64Exact(120),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("paused"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// Update interval and resume in the same plan
+			{
+				Config: testAccUptimeMonitorResourceConfig_Paused(60, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("interval"),
+						knownvalue.Int64Exact(60),
+					),
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("paused"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccUptimeMonitorResource_CreatePaused(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create paused
+			{
+				Config: testAccUptimeMonitorResourceConfig_Paused(60, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("paused"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "phare_uptime_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// The same configuration plans no changes
+			{
+				Config:   testAccUptimeMonitorResourceConfig_Paused(60, true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccUptimeMonitorResource_DefaultAssertions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create without assertions, the API may add a default one
+			{
+				Config: testAccUptimeMonitorResourceConfig_NoAssertions(),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "phare_uptime_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Server-added assertions don't produce a diff
+			{
+				Config:   testAccUptimeMonitorResourceConfig_NoAssertions(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccUptimeMonitorResource_DefaultOperator(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with an assertion omitting its operator
+			{
+				Config: testAccUptimeMonitorResourceConfig_NoOperator(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("success_assertions").AtSliceIndex(0).AtMapKey("operator"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			// The default operator returned by the API doesn't produce a diff
+			{
+				Config:   testAccUptimeMonitorResourceConfig_NoOperator(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccUptimeMonitorResource_Tags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUptimeMonitorResourceConfig_Tags("payments"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("tags"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"team": knownvalue.StringExact("payments"),
+							"env":  knownvalue.StringExact("production"),
+						}),
+					),
+				},
+			},
+			// The Phare API has no monitor tags, so they can't be imported
+			{
+				ResourceName:            "phare_uptime_monitor.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tags"},
+			},
+			// Tags are updated in place
+			{
+				Config: testAccUptimeMonitorResourceConfig_Tags("platform"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("phare_uptime_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("tags").AtMapKey("team"),
+						knownvalue.StringExact("platform"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccUptimeMonitorResource_DeleteMethod(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUptimeMonitorResourceConfig_DeleteMethod(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("http_request").AtMapKey("method"),
+						knownvalue.StringExact("DELETE"),
+					),
+				},
+			},
+			{
+				ResourceName:      "phare_uptime_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccUptimeMonitorResource_ResponseTime(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUptimeMonitorResourceConfig_ResponseTime(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("success_assertions"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"type":     knownvalue.StringExact("status_code"),
+								"operator": knownvalue.StringExact("in"),
+								"value":    knownvalue.StringExact("2xx"),
+								"property": knownvalue.Null(),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"type":     knownvalue.StringExact("response_time"),
+								"operator": knownvalue.StringExact("less_than"),
+								"value":    knownvalue.StringExact("2000"),
+								"property": knownvalue.Null(),
+							}),
+						}),
+					),
+				},
+			},
+			{
+				ResourceName:      "phare_uptime_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccUptimeMonitorResource_CertificateExpiry(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUptimeMonitorResourceConfig_CertificateExpiry(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("success_assertions"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"type":     knownvalue.StringExact("certificate_expiry_days"),
+								"operator": knownvalue.StringExact("less_than"),
+								"value":    knownvalue.StringExact("14"),
+								"property": knownvalue.Null(),
+							}),
+						}),
+					),
+				},
+			},
+			{
+				ResourceName:      "phare_uptime_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccUptimeMonitorResource_AssertionOrder(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Declare the assertions in reverse order from the API response
+			{
+				Config: testAccUptimeMonitorResourceConfig_ReversedAssertions(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"phare_uptime_monitor.test",
+						tfjsonpath.New("success_assertions"),
+						knownvalue.SetSizeExact(2),
+					),
+				},
+			},
+			// The order returned by the API doesn't produce a diff
+			{
+				Config:   testAccUptimeMonitorResourceConfig_ReversedAssertions(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccUptimeMonitorResourceConfig_HTTP(url string, interval int) string {
 	timestamp := time.Now().Unix() % 10000 // Last 4 digits
 	return fmt.Sprintf(`
@@ -132,3 +619,467 @@ resource "phare_uptime_monitor" "test" {
 }
 `, host, port)
 }
+
+func testAccUptimeMonitorResourceConfig_ICMP(host string) string {
+	return fmt.Sprintf(`
+resource "phare_uptime_monitor" "test" {
+  name     = "Test ICMP Monitor"
+  protocol = "icmp"
+
+  icmp_request = {
+    host = %[1]q
+  }
+
+  interval                = 60
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+}
+`, host)
+}
+
+func testAccUptimeMonitorResourceConfig_DNS(recordType, record string) string {
+	return fmt.Sprintf(`
+resource "phare_uptime_monitor" "test" {
+  name     = "Test DNS Monitor"
+  protocol = "dns"
+
+  dns_request = {
+    host        = "one.one.one.one"
+    record_type = %[1]q
+    resolver    = "1.1.1.1"
+  }
+
+  interval                = 60
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+
+  success_assertions = [
+    {
+      type  = "record_value"
+      value = %[2]q
+    }
+  ]
+}
+`, recordType, record)
+}
+
+func testAccUptimeMonitorResourceConfig_Heartbeat(period int) string {
+	return fmt.Sprintf(`
+resource "phare_uptime_monitor" "test" {
+  name     = "Test Heartbeat Monitor"
+  protocol = "heartbeat"
+
+  heartbeat_request = {
+    period = %[1]d
+    grace  = 300
+  }
+
+  interval                = 60
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+}
+`, period)
+}
+
+func testAccUptimeMonitorResourceConfig_TLS(minVersion, serverName string) string {
+	return fmt.Sprintf(`
+resource "phare_uptime_monitor" "test" {
+  name     = "Test TLS Monitor"
+  protocol = "http"
+
+  http_request = {
+    method = "GET"
+    url    = "https://immich.app"
+
+    tls = {
+      min_version = %[1]q
+      server_name = %[2]q
+    }
+  }
+
+  interval                = 60
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+}
+`, minVersion, serverName)
+}
+
+func testAccUptimeMonitorResourceConfig_NoAssertions() string {
+	return `
+resource "phare_uptime_monitor" "test" {
+  name     = "Test Default Assertions"
+  protocol = "http"
+
+  http_request = {
+    method = "GET"
+    url    = "https://immich.app"
+  }
+
+  interval                = 60
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+}
+`
+}
+
+func testAccUptimeMonitorResourceConfig_NoOperator() string {
+	return `
+resource "phare_uptime_monitor" "test" {
+  name     = "Test Default Operator"
+  protocol = "http"
+
+  http_request = {
+    method = "GET"
+    url    = "https://immich.app"
+  }
+
+  interval                = 60
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+
+  success_assertions = [
+    {
+      type  = "status_code"
+      value = "2xx"
+    }
+  ]
+}
+`
+}
+
+func testAccUptimeMonitorResourceConfig_ResponseTime() string {
+	return `
+resource "phare_uptime_monitor" "test" {
+  name     = "Test Response Time"
+  protocol = "http"
+
+  http_request = {
+    method = "GET"
+    url    = "https://immich.app"
+  }
+
+  interval                = 60
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+
+  success_assertions = [
+    {
+      type     = "status_code"
+      operator = "in"
+      value    = "2xx"
+    },
+    {
+      type     = "response_time"
+      operator = "less_than"
+      value    = "2000"
+    }
+  ]
+}
+`
+}
+
+func testAccUptimeMonitorResourceConfig_CertificateExpiry() string {
+	return `
+resource "phare_uptime_monitor" "test" {
+  name     = "Test Certificate Expiry"
+  protocol = "http"
+
+  http_request = {
+    method = "GET"
+    url    = "https://immich.app"
+  }
+
+  interval                = 3600
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+
+  success_assertions = [
+    {
+      type     = "certificate_expiry_days"
+      operator = "less_than"
+      value    = "14"
+    }
+  ]
+}
+`
+}
+
+func testAccUptimeMonitorResourceConfig_ReversedAssertions() string {
+	return `
+resource "phare_uptime_monitor" "test" {
+  name     = "Test Assertion Order"
+  protocol = "http"
+
+  http_request = {
+    method = "GET"
+    url    = "https://immich.app"
+  }
+
+  interval                = 60
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+
+  success_assertions = [
+    {
+      type     = "response_body"
+      operator = "contains"
+      value    = "Immich"
+    },
+    {
+      type     = "status_code"
+      operator = "in"
+      value    = "2xx"
+    }
+  ]
+}
+`
+}
+
+func testAccUptimeMonitorResourceConfig_Tags(team string) string {
+	return fmt.Sprintf(`
+resource "phare_uptime_monitor" "test" {
+  name     = "Test Tagged Monitor"
+  protocol = "http"
+
+  http_request = {
+    method = "GET"
+    url    = "https://immich.app"
+  }
+
+  interval                = 60
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+
+  tags = {
+    team = %[1]q
+    env  = "production"
+  }
+}
+`, team)
+}
+
+func testAccUptimeMonitorResourceConfig_DeleteMethod() string {
+	return `
+resource "phare_uptime_monitor" "test" {
+  name     = "Test DELETE Monitor"
+  protocol = "http"
+
+  http_request = {
+    method = "DELETE"
+    url    = "https://immich.app"
+  }
+
+  interval               = 60
+  timeout                = 5000
+  incident_confirmations = 1
+  recovery_confirmations = 1
+  regions                = ["na-usa-iad"]
+}
+`
+}
+
+func testAccUptimeMonitorResourceConfig_Paused(interval int, paused bool) string {
+	return fmt.Sprintf(`
+resource "phare_uptime_monitor" "test" {
+  name     = "Test Paused Monitor"
+  protocol = "http"
+  paused   = %[2]t
+
+  http_request = {
+    method = "GET"
+    url    = "https://immich.app"
+  }
+
+  interval                = %[1]d
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+}
+`, interval, paused)
+}
+
+func TestIsRedirectStatus(t *testing.T) {
+	tests := map[string]bool{
+		"3xx": true,
+		"3XX": true,
+		"301": true,
+		"399": true,
+		"200": false,
+		"2xx": false,
+		"400": false,
+		"":    false,
+	}
+
+	for value, want := range tests {
+		if got := isRedirectStatus(value); got != want {
+			t.Errorf("isRedirectStatus(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
+
+func TestUptimeMonitorValidateRegionCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxRegions int
+		regions    []string
+		wantError  bool
+	}{
+		{
+			name:       "within plan limit",
+			maxRegions: 2,
+			regions:    []string{"na-usa-iad", "eu-deu-fra"},
+		},
+		{
+			name:       "exceeds plan limit",
+			maxRegions: 2,
+			regions:    []string{"na-usa-iad", "eu-deu-fra", "as-sgp-sin"},
+			wantError:  true,
+		},
+		{
+			name:    "unconfigured limit",
+			regions: []string{"na-usa-iad", "eu-deu-fra", "as-sgp-sin"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &UptimeMonitorResource{maxRegions: tt.maxRegions}
+			data := &UptimeMonitorResourceModel{Regions: stringSetValue(t, tt.regions)}
+
+			var diags diag.Diagnostics
+			r.validateRegionCount(data, &diags)
+
+			if diags.HasError() != tt.wantError {
+				t.Errorf("validateRegionCount() has error = %v, want %v: %v", diags.HasError(), tt.wantError, diags)
+			}
+		})
+	}
+}
+
+// lingeringMonitorAPI keeps deleted monitors readable for a number of reads,
+// simulating an eventually consistent delete.
+type lingeringMonitorAPI struct {
+	*fakeMonitorAPI
+	reads int
+}
+
+func (f *lingeringMonitorAPI) GetMonitor(ctx context.Context, id int) (*client.Monitor, error) {
+	if f.reads > 0 {
+		f.reads--
+		return &client.Monitor{ID: &id}, nil
+	}
+	return f.fakeMonitorAPI.GetMonitor(ctx, id)
+}
+
+func TestWaitForMonitorDeleted(t *testing.T) {
+	tests := []struct {
+		name      string
+		reads     int
+		wantError bool
+	}{
+		{
+			name: "deleted immediately",
+		},
+		{
+			name:  "readable for a few reads",
+			reads: 3,
+		},
+		{
+			name:      "still readable after timeout",
+			reads:     1000,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &lingeringMonitorAPI{fakeMonitorAPI: newFakeMonitorAPI(), reads: tt.reads}
+
+			err := waitForMonitorDeleted(context.Background(), api, 1, 100*time.Millisecond, time.Millisecond)
+
+			if (err != nil) != tt.wantError {
+				t.Errorf("waitForMonitorDeleted() error = %v, want error %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestUptimeMonitorDuplicateNameWarning(t *testing.T) {
+	ctx := context.Background()
+
+	fake := newFakeMonitorAPI()
+	if _, err := fake.CreateMonitor(ctx, &client.Monitor{Name: "API"}); err != nil {
+		t.Fatalf("CreateMonitor() unexpected error: %v", err)
+	}
+	r := &UptimeMonitorResource{client: fake}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name         string
+		planName     string
+		update       bool
+		listErr      error
+		wantWarnings int
+	}{
+		{name: "create with an existing name", planName: "API", wantWarnings: 1},
+		{name: "create with a new name", planName: "Website"},
+		{name: "update with an existing name", planName: "API", update: true},
+		{name: "list error is ignored", planName: "API", listErr: fmt.Errorf("service unavailable")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake.listErr = tt.listErr
+
+			plan := tfsdk.Plan{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			diags := plan.SetAttribute(ctx, path.Root("name"), tt.planName)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			if tt.update {
+				state.Raw = plan.Raw
+			}
+
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount(); got != tt.wantWarnings {
+				t.Errorf("WarningsCount() = %d, want %d: %v", got, tt.wantWarnings, resp.Diagnostics)
+			}
+		})
+	}
+}
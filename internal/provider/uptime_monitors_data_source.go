@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UptimeMonitorsDataSource{}
+
+func NewUptimeMonitorsDataSource() datasource.DataSource {
+	return &UptimeMonitorsDataSource{}
+}
+
+// UptimeMonitorsDataSource defines the data source implementation. Monitors
+// are converted with the API conversion of UptimeMonitorResource.
+type UptimeMonitorsDataSource struct {
+	client *client.Client
+}
+
+// UptimeMonitorsDataSourceModel describes the data source data model.
+type UptimeMonitorsDataSourceModel struct {
+	NameContains types.String `tfsdk:"name_contains"`
+	Protocol     types.String `tfsdk:"protocol"`
+	Monitors     types.List   `tfsdk:"monitors"`
+}
+
+func (d *UptimeMonitorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_uptime_monitors"
+}
+
+func (d *UptimeMonitorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := uptimeMonitorComputedAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The unique identifier of the monitor",
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the monitor",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Phare uptime monitors of the account, optionally filtered by name or protocol.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_contains": schema.StringAttribute{
+				MarkdownDescription: "Only return monitors whose name contains this string, case-sensitive",
+				Optional:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Only return monitors using this protocol: `http`, `tcp`, `icmp`, `dns` or `heartbeat`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(monitorProtocols...),
+				},
+			},
+			"monitors": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching monitors",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: attributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *UptimeMonitorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UptimeMonitorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UptimeMonitorsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing uptime monitors", map[string]any{
+		"name_contains": data.NameContains.ValueString(),
+		"protocol":      data.Protocol.ValueString(),
+	})
+
+	monitors, err := d.client.ListMonitors(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list monitors", err.Error())
+		return
+	}
+
+	monitors = filterMonitors(monitors, data.NameContains.ValueString(), data.Protocol.ValueString())
+
+	monitorList, diags := uptimeMonitorListValue(ctx, monitors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Monitors = monitorList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterMonitors returns the monitors whose name contains nameContains and
+// that use the given protocol, empty filters match every monitor
+func filterMonitors(monitors []client.Monitor, nameContains, protocol string) []client.Monitor {
+	filtered := make([]client.Monitor, 0, len(monitors))
+	for _, monitor := range monitors {
+		if !strings.Contains(monitor.Name, nameContains) {
+			continue
+		}
+		if protocol != "" && monitor.Protocol != protocol {
+			continue
+		}
+		filtered = append(filtered, monitor)
+	}
+
+	return filtered
+}
+
+// uptimeMonitorAttrTypes returns the attribute types of a monitors list element
+func uptimeMonitorAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                         types.StringType,
+		"name":                       types.StringType,
+		"protocol":                   types.StringType,
+		"http_request":               types.ObjectType{AttrTypes: httpRequestAttrTypes()},
+		"tcp_request":                types.ObjectType{AttrTypes: tcpRequestAttrTypes()},
+		"icmp_request":               types.ObjectType{AttrTypes: icmpRequestAttrTypes()},
+		"dns_request":                types.ObjectType{AttrTypes: dnsRequestAttrTypes()},
+		"heartbeat_request":          types.ObjectType{AttrTypes: heartbeatRequestAttrTypes()},
+		"interval":                   types.Int64Type,
+		"timeout":                    types.Int64Type,
+		"incident_confirmations":     types.Int64Type,
+		"recovery_confirmations":     types.Int64Type,
+		"regions":                    types.SetType{ElemType: types.StringType},
+		"success_assertions":         types.SetType{ElemType: types.ObjectType{AttrTypes: successAssertionAttrTypes()}},
+		"paused":                     types.BoolType,
+		"estimated_checks_per_month": types.Int64Type,
+		"status":                     types.StringType,
+		"resolved_ip_family":         types.StringType,
+		"ping_url":                   types.StringType,
+		"created_at":                 types.StringType,
+		"updated_at":                 types.StringType,
+	}
+}
+
+// uptimeMonitorListValue converts API monitors to a monitors list value
+func uptimeMonitorListValue(ctx context.Context, monitors []client.Monitor) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	r := &UptimeMonitorResource{}
+	elements := make([]attr.Value, 0, len(monitors))
+	for i := range monitors {
+		var m UptimeMonitorResourceModel
+		diags.Append(r.apiToTerraformModel(ctx, &monitors[i], &m)...)
+		if diags.HasError() {
+			return types.ListNull(types.ObjectType{AttrTypes: uptimeMonitorAttrTypes()}), diags
+		}
+
+		monitorObj, objDiags := types.ObjectValue(uptimeMonitorAttrTypes(), map[string]attr.Value{
+			"id":                         m.ID,
+			"name":                       m.Name,
+			"protocol":                   m.Protocol,
+			"http_request":               m.HTTPRequest,
+			"tcp_request":                m.TCPRequest,
+			"icmp_request":               m.ICMPRequest,
+			"dns_request":                m.DNSRequest,
+			"heartbeat_request":          m.HeartbeatRequest,
+			"interval":                   m.Interval,
+			"timeout":                    m.Timeout,
+			"incident_confirmations":     m.IncidentConfirmations,
+			"recovery_confirmations":     m.RecoveryConfirmations,
+			"regions":                    m.Regions,
+			"success_assertions":         m.SuccessAssertions,
+			"paused":                     m.Paused,
+			"estimated_checks_per_month": m.EstimatedChecks,
+			"status":                     m.Status,
+			"resolved_ip_family":         m.ResolvedIPFamily,
+			"ping_url":                   m.PingURL,
+			"created_at":                 m.CreatedAt,
+			"updated_at":                 m.UpdatedAt,
+		})
+		diags.Append(objDiags...)
+		elements = append(elements, monitorObj)
+	}
+
+	list, listDiags := types.ListValue(types.ObjectType{AttrTypes: uptimeMonitorAttrTypes()}, elements)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/phare/terraform-provider-phare/internal/client"
+)
+
+func TestAccUptimeMonitorsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUptimeMonitorsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListNotEmpty("data.phare_uptime_monitors.test", "monitors"),
+					resource.TestCheckResourceAttrSet("data.phare_uptime_monitors.test", "monitors.0.id"),
+					resource.TestCheckResourceAttrSet("data.phare_uptime_monitors.test", "monitors.0.name"),
+					resource.TestCheckResourceAttr("data.phare_uptime_monitors.filtered", "monitors.#", "1"),
+					resource.TestCheckResourceAttr("data.phare_uptime_monitors.filtered", "monitors.0.name", "Test List Monitors"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckListNotEmpty checks that the list attribute has at least one element
+func testAccCheckListNotEmpty(name, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("%s not found in state", name)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes[key+".#"])
+		if err != nil || count == 0 {
+			return fmt.Errorf("%s: expected %s to have at least one element, got %q", name, key, rs.Primary.Attributes[key+".#"])
+		}
+
+		return nil
+	}
+}
+
+func testAccUptimeMonitorsDataSourceConfig() string {
+	return `
+resource "phare_uptime_monitor" "test" {
+  name     = "Test List Monitors"
+  protocol = "http"
+
+  http_request = {
+    method = "GET"
+    url    = "https://immich.app"
+  }
+
+  interval                = 60
+  timeout                 = 5000
+  incident_confirmations  = 1
+  recovery_confirmations  = 1
+  regions                 = ["na-usa-iad"]
+}
+
+data "phare_uptime_monitors" "test" {
+  depends_on = [phare_uptime_monitor.test]
+}
+
+data "phare_uptime_monitors" "filtered" {
+  name_contains = "Test List Monitors"
+  protocol      = "http"
+
+  depends_on = [phare_uptime_monitor.test]
+}
+`
+}
+
+func TestUptimeMonitorsDataSourceStateMatchesSchema(t *testing.T) {
+	ctx := context.Background()
+	d := &UptimeMonitorsDataSource{}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	httpID, tcpID := 1, 2
+	monitors := []client.Monitor{
+		{
+			ID:       &httpID,
+			Name:     "api",
+			Protocol: "http",
+			Interval: 60,
+			Regions:  []string{"na-usa-iad"},
+			Request: client.MonitorRequest{
+				Method: stringPtr("GET"),
+				URL:    stringPtr("https://example.com"),
+			},
+		},
+		{
+			ID:       &tcpID,
+			Name:     "db",
+			Protocol: "tcp",
+			Interval: 60,
+			Regions:  []string{"eu-deu-fra"},
+			Request: client.MonitorRequest{
+				Host:       stringPtr("db.example.com"),
+				Port:       stringPtr("5432"),
+				Connection: stringPtr("tls"),
+			},
+		},
+	}
+
+	monitorList, diags := uptimeMonitorListValue(ctx, monitors)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags.Append(state.Set(ctx, &UptimeMonitorsDataSourceModel{Monitors: monitorList})...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := len(monitorList.Elements()); got != 2 {
+		t.Errorf("got %d monitors, want 2", got)
+	}
+}
+
+func TestFilterMonitors(t *testing.T) {
+	monitors := []client.Monitor{
+		{Name: "api-prod", Protocol: "http"},
+		{Name: "db-prod", Protocol: "tcp"},
+		{Name: "api-staging", Protocol: "http"},
+	}
+
+	tests := []struct {
+		name         string
+		nameContains string
+		protocol     string
+		want         []string
+	}{
+		{
+			name: "unfiltered",
+			want: []string{"api-prod", "db-prod", "api-staging"},
+		},
+		{
+			name:         "filtered by name",
+			nameContains: "prod",
+			want:         []string{"api-prod", "db-prod"},
+		},
+		{
+			name:     "filtered by protocol",
+			protocol: "tcp",
+			want:     []string{"db-prod"},
+		},
+		{
+			name:         "filtered by name and protocol",
+			nameContains: "api",
+			protocol:     "http",
+			want:         []string{"api-prod", "api-staging"},
+		},
+		{
+			name:         "name is case-sensitive",
+			nameContains: "API",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, monitor := range filterMonitors(monitors, tt.nameContains, tt.protocol) {
+				got = append(got, monitor.Name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("filterMonitors() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
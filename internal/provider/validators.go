@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.String = absoluteHTTPURLValidator{}
+
+// absoluteHTTPURLValidator checks that a value is an absolute http or https URL.
+type absoluteHTTPURLValidator struct{}
+
+// IsAbsoluteHTTPURL returns a validator which ensures that a string is an
+// absolute http or https URL with a host.
+func IsAbsoluteHTTPURL() validator.String {
+	return absoluteHTTPURLValidator{}
+}
+
+func (v absoluteHTTPURLValidator) Description(ctx context.Context) string {
+	return "value must be an absolute http or https URL such as https://example.com/health"
+}
+
+func (v absoluteHTTPURLValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an absolute `http` or `https` URL such as `https://example.com/health`"
+}
+
+func (v absoluteHTTPURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// The API trims the URL before validating it
+	value := req.ConfigValue.ValueString()
+	parsed, err := url.Parse(strings.TrimSpace(value))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("%q is not a valid URL: %s", value, err),
+		)
+		return
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("%q must be an absolute URL using the http or https scheme, e.g. https://example.com/health", value),
+		)
+		return
+	}
+
+	if parsed.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("%q has no host, e.g. https://example.com/health", value),
+		)
+	}
+}
+
+// portRegexp matches a port number given as decimal digits.
+var portRegexp = regexp.MustCompile(`^[0-9]+$`)
+
+var _ validator.String = portValidator{}
+
+// portValidator checks that a numeric string is a port between 1 and 65535.
+// Non-numeric values are reported by the portRegexp validator.
+type portValidator struct{}
+
+func (v portValidator) Description(ctx context.Context) string {
+	return "value must be a port between 1 and 65535"
+}
+
+func (v portValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a port between `1` and `65535`"
+}
+
+func (v portValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if !portRegexp.MatchString(value) {
+		return
+	}
+
+	// Digits overflowing an int are out of range as well
+	if port, err := strconv.Atoi(value); err != nil || port < 1 || port > 65535 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Port",
+			fmt.Sprintf("%q is not a port between 1 and 65535", value),
+		)
+	}
+}
+
+// protocolRequestAttributes maps each monitor protocol to the attribute
+// configuring its request.
+var protocolRequestAttributes = map[string]string{
+	"http":      "http_request",
+	"tcp":       "tcp_request",
+	"icmp":      "icmp_request",
+	"dns":       "dns_request",
+	"heartbeat": "heartbeat_request",
+}
+
+var _ resource.ConfigValidator = protocolRequestValidator{}
+
+// protocolRequestValidator checks that the request attribute of the monitor
+// protocol is set, and that the request attributes of other protocols are not.
+// It validates the whole resource configuration rather than each request
+// attribute, as it compares every request attribute against protocol.
+type protocolRequestValidator struct{}
+
+func (v protocolRequestValidator) Description(ctx context.Context) string {
+	return "http_request must be set when protocol is http, tcp_request when protocol is tcp, icmp_request when protocol is icmp, dns_request when protocol is dns and heartbeat_request when protocol is heartbeat"
+}
+
+func (v protocolRequestValidator) MarkdownDescription(ctx context.Context) string {
+	return "`http_request` must be set when `protocol` is `http`, `tcp_request` when `protocol` is `tcp`, `icmp_request` when `protocol` is `icmp`, `dns_request` when `protocol` is `dns` and `heartbeat_request` when `protocol` is `heartbeat`"
+}
+
+func (v protocolRequestValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var protocol types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("protocol"), &protocol)...)
+	if resp.Diagnostics.HasError() || protocol.IsNull() || protocol.IsUnknown() {
+		return
+	}
+
+	for _, requestProtocol := range slices.Sorted(maps.Keys(protocolRequestAttributes)) {
+		name := protocolRequestAttributes[requestProtocol]
+
+		var request types.Object
+		diags := req.Config.GetAttribute(ctx, path.Root(name), &request)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+
+		switch {
+		case requestProtocol == protocol.ValueString() && request.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Request Configuration",
+				fmt.Sprintf("%s is required when protocol is %q.", name, protocol.ValueString()),
+			)
+		case requestProtocol != protocol.ValueString() && !request.IsNull() && !request.IsUnknown():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Conflicting Request Configuration",
+				fmt.Sprintf("%s can only be set when protocol is %q, but protocol is %q.", name, requestProtocol, protocol.ValueString()),
+			)
+		}
+	}
+}
+
+var _ resource.ConfigValidator = maintenanceDowntimeValidator{}
+
+// maintenanceDowntimeValidator warns when a maintenance incident is
+// explicitly counted as downtime. It only warns: the value is kept as
+// configured, as Terraform doesn't allow planning another value.
+type maintenanceDowntimeValidator struct{}
+
+func (v maintenanceDowntimeValidator) Description(ctx context.Context) string {
+	return "exclude_from_downtime should not be false when impact is maintenance"
+}
+
+func (v maintenanceDowntimeValidator) MarkdownDescription(ctx context.Context) string {
+	return "`exclude_from_downtime` should not be `false` when `impact` is `maintenance`"
+}
+
+func (v maintenanceDowntimeValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var impact types.String
+	var excludeFromDowntime types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("impact"), &impact)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("exclude_from_downtime"), &excludeFromDowntime)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if impact.ValueString() == "maintenance" && !excludeFromDowntime.IsNull() && !excludeFromDowntime.IsUnknown() && !excludeFromDowntime.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("exclude_from_downtime"),
+			"Maintenance Counted As Downtime",
+			"exclude_from_downtime is false while impact is \"maintenance\", so the maintenance counts as downtime. "+
+				"Remove exclude_from_downtime to exclude it from downtime calculations.",
+		)
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestIsAbsoluteHTTPURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{
+			name:  "https",
+			value: types.StringValue("https://example.com/health"),
+		},
+		{
+			name:  "http with port and query",
+			value: types.StringValue("http://example.com:8080/health?full=1"),
+		},
+		{
+			name:  "surrounding whitespace",
+			value: types.StringValue(" https://example.com "),
+		},
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:  "unknown",
+			value: types.StringUnknown(),
+		},
+		{
+			name:      "relative path",
+			value:     types.StringValue("/health"),
+			wantError: true,
+		},
+		{
+			name:      "missing scheme",
+			value:     types.StringValue("example.com/health"),
+			wantError: true,
+		},
+		{
+			name:      "empty host",
+			value:     types.StringValue("https:///health"),
+			wantError: true,
+		},
+		{
+			name:      "ftp scheme",
+			value:     types.StringValue("ftp://example.com/file"),
+			wantError: true,
+		},
+		{
+			name:      "unparsable",
+			value:     types.StringValue("https://exa mple.com/%zz"),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("http_request").AtName("url"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+
+			IsAbsoluteHTTPURL().ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Errorf("HasError() = %v, want %v: %v", got, tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestPortValidator(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&UptimeMonitorResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	tcpRequest := schemaResp.Schema.Attributes["tcp_request"].(schema.SingleNestedAttribute)
+	port := tcpRequest.Attributes["port"].(schema.StringAttribute)
+
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{
+			name:  "lowest port",
+			value: types.StringValue("1"),
+		},
+		{
+			name:  "highest port",
+			value: types.StringValue("65535"),
+		},
+		{
+			name:  "common port",
+			value: types.StringValue("5432"),
+		},
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:  "unknown",
+			value: types.StringUnknown(),
+		},
+		{
+			name:      "zero",
+			value:     types.StringValue("0"),
+			wantError: true,
+		},
+		{
+			name:      "above range",
+			value:     types.StringValue("65536"),
+			wantError: true,
+		},
+		{
+			name:      "far above range",
+			value:     types.StringValue("99999999999999999999"),
+			wantError: true,
+		},
+		{
+			name:      "negative",
+			value:     types.StringValue("-1"),
+			wantError: true,
+		},
+		{
+			name:      "service name",
+			value:     types.StringValue("https"),
+			wantError: true,
+		},
+		{
+			name:      "surrounding whitespace",
+			value:     types.StringValue(" 443"),
+			wantError: true,
+		},
+		{
+			name:      "empty",
+			value:     types.StringValue(""),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("tcp_request").AtName("port"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+			for _, v := range port.StringValidators() {
+				v.ValidateString(ctx, req, resp)
+			}
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Errorf("HasError() = %v, want %v: %v", got, tt.wantError, resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.ErrorsCount(); got > 1 {
+				t.Errorf("got %d errors, want at most one: %v", got, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestProtocolRequestValidator(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeMonitorResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name      string
+		protocol  types.String
+		http      bool
+		tcp       bool
+		icmp      bool
+		dns       bool
+		heartbeat bool
+		wantPaths []path.Path
+	}{
+		{
+			name:     "http with http_request",
+			protocol: types.StringValue("http"),
+			http:     true,
+		},
+		{
+			name:     "tcp with tcp_request",
+			protocol: types.StringValue("tcp"),
+			tcp:      true,
+		},
+		{
+			name:      "http without http_request",
+			protocol:  types.StringValue("http"),
+			wantPaths: []path.Path{path.Root("http_request")},
+		},
+		{
+			name:      "tcp with both requests",
+			protocol:  types.StringValue("tcp"),
+			http:      true,
+			tcp:       true,
+			wantPaths: []path.Path{path.Root("http_request")},
+		},
+		{
+			name:     "icmp with icmp_request",
+			protocol: types.StringValue("icmp"),
+			icmp:     true,
+		},
+		{
+			name:      "icmp with tcp_request",
+			protocol:  types.StringValue("icmp"),
+			tcp:       true,
+			wantPaths: []path.Path{path.Root("icmp_request"), path.Root("tcp_request")},
+		},
+		{
+			name:     "dns with dns_request",
+			protocol: types.StringValue("dns"),
+			dns:      true,
+		},
+		{
+			name:      "http with dns_request",
+			protocol:  types.StringValue("http"),
+			http:      true,
+			dns:       true,
+			wantPaths: []path.Path{path.Root("dns_request")},
+		},
+		{
+			name:      "heartbeat with heartbeat_request",
+			protocol:  types.StringValue("heartbeat"),
+			heartbeat: true,
+		},
+		{
+			name:      "heartbeat without heartbeat_request",
+			protocol:  types.StringValue("heartbeat"),
+			wantPaths: []path.Path{path.Root("heartbeat_request")},
+		},
+		{
+			name:     "unknown protocol",
+			protocol: types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			state := tfsdk.State(config)
+			diags := state.SetAttribute(ctx, path.Root("protocol"), tt.protocol)
+			if tt.http {
+				diags.Append(state.SetAttribute(ctx, path.Root("http_request").AtName("url"), "https://example.com")...)
+			}
+			if tt.tcp {
+				diags.Append(state.SetAttribute(ctx, path.Root("tcp_request").AtName("host"), "example.com")...)
+			}
+			if tt.icmp {
+				diags.Append(state.SetAttribute(ctx, path.Root("icmp_request").AtName("host"), "example.com")...)
+			}
+			if tt.dns {
+				diags.Append(state.SetAttribute(ctx, path.Root("dns_request").AtName("host"), "example.com")...)
+			}
+			if tt.heartbeat {
+				diags.Append(state.SetAttribute(ctx, path.Root("heartbeat_request").AtName("period"), 3600)...)
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			config.Raw = state.Raw
+
+			resp := &resource.ValidateConfigResponse{}
+			protocolRequestValidator{}.ValidateResource(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+
+			errors := resp.Diagnostics.Errors()
+			if len(errors) != len(tt.wantPaths) {
+				t.Fatalf("got %d errors, want %d: %v", len(errors), len(tt.wantPaths), resp.Diagnostics)
+			}
+			for i, want := range tt.wantPaths {
+				withPath, ok := errors[i].(interface{ Path() path.Path })
+				if !ok || !withPath.Path().Equal(want) {
+					t.Errorf("error %d is not attached to %s: %v", i, want, errors[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMaintenanceDowntimeValidator(t *testing.T) {
+	ctx := context.Background()
+	r := &UptimeIncidentResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name                string
+		impact              types.String
+		excludeFromDowntime types.Bool
+		wantWarning         bool
+	}{
+		{
+			name:                "maintenance without exclude_from_downtime",
+			impact:              types.StringValue("maintenance"),
+			excludeFromDowntime: types.BoolNull(),
+		},
+		{
+			name:                "maintenance excluded from downtime",
+			impact:              types.StringValue("maintenance"),
+			excludeFromDowntime: types.BoolValue(true),
+		},
+		{
+			name:                "maintenance counted as downtime",
+			impact:              types.StringValue("maintenance"),
+			excludeFromDowntime: types.BoolValue(false),
+			wantWarning:         true,
+		},
+		{
+			name:                "outage counted as downtime",
+			impact:              types.StringValue("majorOutage"),
+			excludeFromDowntime: types.BoolValue(false),
+		},
+		{
+			name:                "unknown impact",
+			impact:              types.StringUnknown(),
+			excludeFromDowntime: types.BoolValue(false),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			state := tfsdk.State(config)
+			diags := state.SetAttribute(ctx, path.Root("impact"), tt.impact)
+			diags.Append(state.SetAttribute(ctx, path.Root("exclude_from_downtime"), tt.excludeFromDowntime)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			config.Raw = state.Raw
+
+			resp := &resource.ValidateConfigResponse{}
+			maintenanceDowntimeValidator{}.ValidateResource(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+				t.Errorf("warning = %t, want %t: %v", got, tt.wantWarning, resp.Diagnostics)
+			}
+		})
+	}
+}